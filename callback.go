@@ -0,0 +1,450 @@
+package gobot
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	callbackDataMaxLength  = 64
+	callbackSignatureSize  = 8
+	callbackStoreKeySize   = 8
+	callbackStoreMaxValues = 10000
+)
+
+var (
+	ErrCallbackDataTooLong    = errors.New("callback data is longer than 64 bytes")
+	ErrCallbackDataMalformed  = errors.New("malformed callback data")
+	ErrCallbackDataSignature  = errors.New("invalid callback data signature")
+	ErrCallbackDataNotStored  = errors.New("callback data not found in store")
+	ErrCallbackPrefixInvalid  = errors.New("callback prefix must be non-empty and must not contain ':', '|', '~', '#' or '\\'")
+	ErrCallbackDataNotStruct  = errors.New("callback data must be a struct or a pointer to a struct")
+	ErrCallbackFieldNotSimple = errors.New("callback data fields must be strings, booleans or numbers")
+)
+
+// CallbackStore keeps the payloads that don't fit in the 64 bytes of callback_data.
+type CallbackStore interface {
+	Set(key string, value string) error
+	Get(key string) (string, bool)
+}
+
+type memoryCallbackStore struct {
+	mutex  sync.RWMutex
+	values map[string]string
+	keys   []string
+	next   int
+}
+
+// NewMemoryCallbackStore returns an in-memory store that keeps the last 10000 payloads.
+func NewMemoryCallbackStore() CallbackStore {
+	return &memoryCallbackStore{
+		values: map[string]string{},
+		keys:   make([]string, callbackStoreMaxValues),
+	}
+}
+
+func (store *memoryCallbackStore) Set(key string, value string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if oldKey := store.keys[store.next]; oldKey != "" {
+		delete(store.values, oldKey)
+	}
+	store.keys[store.next] = key
+	store.next = (store.next + 1) % len(store.keys)
+	store.values[key] = value
+	return nil
+}
+
+func (store *memoryCallbackStore) Get(key string) (string, bool) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	value, ok := store.values[key]
+	return value, ok
+}
+
+// CallbackCodec encodes structs into callback data in the form "prefix:field|field...".
+// Payloads longer than 64 bytes are moved to the Store and replaced by a short key.
+type CallbackCodec struct {
+	Secret []byte        // Optional. If set, the callback data is signed with HMAC-SHA256 so that users can't forge it
+	Store  CallbackStore // Optional. Storage used for the payloads that exceed 64 bytes
+}
+
+func NewCallbackCodec(secret []byte) *CallbackCodec {
+	return &CallbackCodec{
+		Secret: secret,
+		Store:  NewMemoryCallbackStore(),
+	}
+}
+
+func (codec *CallbackCodec) Encode(prefix string, data interface{}) (string, error) {
+	if !validCallbackPrefix(prefix) {
+		return "", ErrCallbackPrefixInvalid
+	}
+	body, err := encodeCallbackFields(data)
+
+	if err != nil {
+		return "", err
+	}
+	encoded := codec.sign(prefix + ":" + body)
+
+	if len(encoded) <= callbackDataMaxLength {
+		return encoded, nil
+	} else if codec.Store == nil {
+		return "", ErrCallbackDataTooLong
+	}
+	key := make([]byte, callbackStoreKeySize)
+
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	encodedKey := base64.RawURLEncoding.EncodeToString(key)
+
+	if err := codec.Store.Set(encodedKey, body); err != nil {
+		return "", err
+	}
+	encoded = codec.sign(prefix + ":#" + encodedKey)
+
+	if len(encoded) > callbackDataMaxLength {
+		return "", ErrCallbackDataTooLong
+	}
+	return encoded, nil
+}
+
+func (codec *CallbackCodec) Decode(callbackData string, data interface{}) error {
+	payload, err := codec.verify(callbackData)
+
+	if err != nil {
+		return err
+	}
+	separator := strings.IndexByte(payload, ':')
+
+	if separator < 0 {
+		return ErrCallbackDataMalformed
+	}
+	body := payload[separator+1:]
+
+	if strings.HasPrefix(body, "#") {
+		if codec.Store == nil {
+			return ErrCallbackDataNotStored
+		}
+		var ok bool
+		body, ok = codec.Store.Get(body[1:])
+
+		if !ok {
+			return ErrCallbackDataNotStored
+		}
+	}
+
+	if data == nil {
+		return nil
+	}
+	return decodeCallbackFields(body, data)
+}
+
+func (codec *CallbackCodec) sign(payload string) string {
+	if len(codec.Secret) == 0 {
+		return payload
+	}
+	return payload + "~" + codec.signature(payload)
+}
+
+func (codec *CallbackCodec) verify(callbackData string) (string, error) {
+	if len(codec.Secret) == 0 {
+		return callbackData, nil
+	}
+	separator := strings.LastIndexByte(callbackData, '~')
+
+	if separator < 0 {
+		return "", ErrCallbackDataSignature
+	}
+	payload := callbackData[:separator]
+
+	if !hmac.Equal([]byte(callbackData[separator+1:]), []byte(codec.signature(payload))) {
+		return "", ErrCallbackDataSignature
+	}
+	return payload, nil
+}
+
+func (codec *CallbackCodec) signature(payload string) string {
+	mac := hmac.New(sha256.New, codec.Secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:callbackSignatureSize])
+}
+
+// CallbackPrefix returns the prefix of callback data produced by a CallbackCodec.
+func CallbackPrefix(callbackData string) string {
+	if separator := strings.IndexByte(callbackData, ':'); separator >= 0 {
+		return callbackData[:separator]
+	}
+	return callbackData
+}
+
+func validCallbackPrefix(prefix string) bool {
+	return prefix != "" && !strings.ContainsAny(prefix, ":|~#\\")
+}
+
+var callbackEscaper = strings.NewReplacer("\\", "\\\\", "|", "\\|", "~", "\\~", "#", "\\#")
+
+func encodeCallbackFields(data interface{}) (string, error) {
+	if data == nil {
+		return "", nil
+	}
+	v := reflect.Indirect(reflect.ValueOf(data))
+
+	if v.Kind() != reflect.Struct {
+		return "", ErrCallbackDataNotStruct
+	}
+	var fields []string
+
+	for i := 0; i < v.NumField(); i++ {
+		if !isCallbackField(v.Type().Field(i)) {
+			continue
+		}
+		field := v.Field(i)
+		var encoded string
+
+		switch field.Kind() {
+		case reflect.String:
+			encoded = callbackEscaper.Replace(field.String())
+		case reflect.Bool:
+			if field.Bool() {
+				encoded = "1"
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if field.Int() != 0 {
+				encoded = strconv.FormatInt(field.Int(), 36)
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if field.Uint() != 0 {
+				encoded = strconv.FormatUint(field.Uint(), 36)
+			}
+		case reflect.Float32, reflect.Float64:
+			if field.Float() != 0 {
+				encoded = strconv.FormatFloat(field.Float(), 'g', -1, 64)
+			}
+		default:
+			return "", ErrCallbackFieldNotSimple
+		}
+		fields = append(fields, encoded)
+	}
+
+	for len(fields) > 0 && fields[len(fields)-1] == "" {
+		fields = fields[:len(fields)-1]
+	}
+	return strings.Join(fields, "|"), nil
+}
+
+func decodeCallbackFields(body string, data interface{}) error {
+	v := reflect.ValueOf(data)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrCallbackDataNotStruct
+	}
+	v = v.Elem()
+	fields := splitCallbackFields(body)
+	index := 0
+
+	for i := 0; i < v.NumField(); i++ {
+		if !isCallbackField(v.Type().Field(i)) {
+			continue
+		}
+		field := v.Field(i)
+		encoded := ""
+
+		if index < len(fields) {
+			encoded = fields[index]
+		}
+		index++
+
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(encoded)
+		case reflect.Bool:
+			field.SetBool(encoded == "1")
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if encoded == "" {
+				field.SetInt(0)
+				continue
+			}
+			value, err := strconv.ParseInt(encoded, 36, field.Type().Bits())
+
+			if err != nil {
+				return fmt.Errorf("%w: field %s: %v", ErrCallbackDataMalformed, v.Type().Field(i).Name, err)
+			}
+			field.SetInt(value)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if encoded == "" {
+				field.SetUint(0)
+				continue
+			}
+			value, err := strconv.ParseUint(encoded, 36, field.Type().Bits())
+
+			if err != nil {
+				return fmt.Errorf("%w: field %s: %v", ErrCallbackDataMalformed, v.Type().Field(i).Name, err)
+			}
+			field.SetUint(value)
+		case reflect.Float32, reflect.Float64:
+			if encoded == "" {
+				field.SetFloat(0)
+				continue
+			}
+			value, err := strconv.ParseFloat(encoded, field.Type().Bits())
+
+			if err != nil {
+				return fmt.Errorf("%w: field %s: %v", ErrCallbackDataMalformed, v.Type().Field(i).Name, err)
+			}
+			field.SetFloat(value)
+		default:
+			return ErrCallbackFieldNotSimple
+		}
+	}
+
+	if index < len(fields) {
+		return ErrCallbackDataMalformed
+	}
+	return nil
+}
+
+func splitCallbackFields(body string) []string {
+	if body == "" {
+		return nil
+	}
+	var fields []string
+	var field strings.Builder
+
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			if i+1 < len(body) {
+				i++
+				field.WriteByte(body[i])
+			}
+		case '|':
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteByte(body[i])
+		}
+	}
+	return append(fields, field.String())
+}
+
+func isCallbackField(field reflect.StructField) bool {
+	return field.PkgPath == "" && field.Tag.Get("callback") != "-"
+}
+
+type callbackRoute struct {
+	dataType reflect.Type
+	callback reflect.Value
+}
+
+type callbackRouter struct {
+	mutex   sync.RWMutex
+	routes  map[string]callbackRoute
+	onError func(bot *GoBot, update *Update, err error)
+}
+
+// CallbackExpiredText is the alert shown by default when the data of a callback query can't be decoded.
+var CallbackExpiredText = "This button has expired."
+
+var (
+	callbackTypeBot    = reflect.TypeOf(&GoBot{})
+	callbackTypeUpdate = reflect.TypeOf(&Update{})
+)
+
+// OnCallback registers a callback for the callback queries whose data was encoded with the given prefix.
+// The callback must be either a func(*GoBot, *Update) or a func(*GoBot, *Update, *T), where T is the
+// struct the callback data is decoded into. The callbacks run before the handlers of callback queries added
+// with AddHandler, and can be registered while the bot is handling updates. The default CallbackCodec doesn't sign the data, so a user can
+// forge it: give the codec a Secret, or check that the user may do what the decoded data asks.
+func (bot *GoBot) OnCallback(prefix string, callback interface{}) {
+	if !validCallbackPrefix(prefix) {
+		panic(ErrCallbackPrefixInvalid.Error())
+	}
+	value := reflect.ValueOf(callback)
+
+	if value.Kind() != reflect.Func {
+		panic("Callback must be a function")
+	}
+	callbackType := value.Type()
+
+	if callbackType.NumOut() != 0 || (callbackType.NumIn() != 2 && callbackType.NumIn() != 3) ||
+		callbackType.In(0) != callbackTypeBot || callbackType.In(1) != callbackTypeUpdate {
+		panic("Callback must be a func(*GoBot, *Update) or a func(*GoBot, *Update, *T)")
+	}
+	route := callbackRoute{callback: value}
+
+	if callbackType.NumIn() == 3 {
+		dataType := callbackType.In(2)
+
+		if dataType.Kind() != reflect.Ptr || dataType.Elem().Kind() != reflect.Struct {
+			panic("Callback data type must be a pointer to a struct")
+		}
+		route.dataType = dataType.Elem()
+	}
+
+	bot.callbacks.mutex.Lock()
+	bot.callbacks.routes[prefix] = route
+	bot.callbacks.mutex.Unlock()
+}
+
+// OnCallbackError sets the callback called when the data of a callback query with a registered prefix
+// can't be decoded, e.g. because it was forged or its payload was evicted from the store. The callback
+// has to answer the query, which by default is answered with an alert showing CallbackExpiredText.
+func (bot *GoBot) OnCallbackError(callback func(bot *GoBot, update *Update, err error)) {
+	bot.callbacks.mutex.Lock()
+	bot.callbacks.onError = callback
+	bot.callbacks.mutex.Unlock()
+}
+
+// CallbackData encodes the data with the bot's CallbackCodec, to be used as callback_data of a button.
+func (bot GoBot) CallbackData(prefix string, data interface{}) (string, error) {
+	return bot.callbackCodec().Encode(prefix, data)
+}
+
+func (bot GoBot) callbackCodec() *CallbackCodec {
+	if bot.CallbackCodec == nil {
+		return defaultCallbackCodec
+	}
+	return bot.CallbackCodec
+}
+
+var defaultCallbackCodec = NewCallbackCodec(nil)
+
+func (router *callbackRouter) handle(bot *GoBot, update *Update) {
+	router.mutex.RLock()
+	route, ok := router.routes[CallbackPrefix(update.CallbackQuery.Data)]
+	onError := router.onError
+	router.mutex.RUnlock()
+
+	if !ok {
+		return
+	}
+	args := []reflect.Value{reflect.ValueOf(bot), reflect.ValueOf(update)}
+	var err error
+
+	if route.dataType == nil {
+		err = bot.callbackCodec().Decode(update.CallbackQuery.Data, nil)
+	} else {
+		data := reflect.New(route.dataType)
+		err = bot.callbackCodec().Decode(update.CallbackQuery.Data, data.Interface())
+		args = append(args, data)
+	}
+
+	if err == nil {
+		route.callback.Call(args)
+	} else if onError != nil {
+		onError(bot, update, err)
+	} else {
+		_, _ = bot.AnswerCallbackQuery(update.CallbackQuery.NewAnswerCallbackQuery(CallbackExpiredText, true))
+	}
+}
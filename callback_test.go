@@ -0,0 +1,214 @@
+package gobot
+
+import (
+	"errors"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+type callbackTestData struct {
+	Text    string
+	Flag    bool
+	Count   int
+	Id      uint64
+	Ratio   float64
+	Skipped string `callback:"-"`
+	hidden  string
+}
+
+func TestCallbackCodecRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    callbackTestData
+		encoded string
+	}{
+		{"zero", callbackTestData{}, "p:"},
+		{"numbers", callbackTestData{Count: -35, Id: 36, Ratio: 0.5}, "p:||-z|10|0.5"},
+		{"trailing fields", callbackTestData{Text: "a", Flag: true}, "p:a|1"},
+		{"escaping", callbackTestData{Text: `a|b~c#d\e:f`}, `p:a\|b\~c\#d\\e:f`},
+	}
+
+	for _, test := range tests {
+		codec := NewCallbackCodec(nil)
+		encoded, err := codec.Encode("p", test.data)
+
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		} else if encoded != test.encoded {
+			t.Errorf("%s: expected %q, got %q", test.name, test.encoded, encoded)
+		}
+		var decoded callbackTestData
+
+		if err := codec.Decode(encoded, &decoded); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		} else if decoded != test.data {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.data, decoded)
+		}
+	}
+}
+
+func TestCallbackCodecIgnoresSkippedFields(t *testing.T) {
+	codec := NewCallbackCodec(nil)
+	encoded, err := codec.Encode("p", callbackTestData{Skipped: "x", hidden: "y"})
+
+	if err != nil {
+		t.Fatal(err)
+	} else if encoded != "p:" {
+		t.Errorf("expected the skipped fields not to be encoded, got %q", encoded)
+	}
+}
+
+func TestCallbackCodecSignature(t *testing.T) {
+	codec := NewCallbackCodec([]byte("secret"))
+	encoded, err := codec.Encode("p", callbackTestData{Text: "a~b", Count: 3})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded callbackTestData
+
+	if err := codec.Decode(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	} else if decoded.Text != "a~b" || decoded.Count != 3 {
+		t.Errorf("unexpected decoded data %+v", decoded)
+	}
+	separator := strings.LastIndexByte(encoded, '~')
+	forged := []string{
+		"p:a\\~b|||4" + encoded[separator:],                 // Changed payload
+		encoded[:separator],                                 // Missing signature
+		encoded[:separator] + "~AAAAAAAAAAA",                // Wrong signature
+		"p:a|||3",                                           // Unsigned data
+		encoded[:len(encoded)-1],                            // Truncated signature
+		"other" + encoded[strings.IndexByte(encoded, ':'):], // Changed prefix
+	}
+
+	for _, data := range forged {
+		if err := codec.Decode(data, &decoded); !errors.Is(err, ErrCallbackDataSignature) {
+			t.Errorf("%q: expected ErrCallbackDataSignature, got %v", data, err)
+		}
+	}
+
+	if err := NewCallbackCodec([]byte("other")).Decode(encoded, &decoded); !errors.Is(err, ErrCallbackDataSignature) {
+		t.Errorf("expected ErrCallbackDataSignature with another secret, got %v", err)
+	}
+}
+
+func TestCallbackCodecStore(t *testing.T) {
+	codec := NewCallbackCodec([]byte("secret"))
+	data := callbackTestData{Text: strings.Repeat("x", 100)}
+	encoded, err := codec.Encode("p", data)
+
+	if err != nil {
+		t.Fatal(err)
+	} else if len(encoded) > callbackDataMaxLength || !strings.HasPrefix(encoded, "p:#") {
+		t.Fatalf("expected a stored payload, got %q", encoded)
+	}
+	var decoded callbackTestData
+
+	if err := codec.Decode(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	} else if decoded != data {
+		t.Errorf("expected %+v, got %+v", data, decoded)
+	}
+
+	if err := NewCallbackCodec(nil).Decode("p:#missing", &decoded); !errors.Is(err, ErrCallbackDataNotStored) {
+		t.Errorf("expected ErrCallbackDataNotStored, got %v", err)
+	}
+	codec = &CallbackCodec{}
+
+	if _, err := codec.Encode("p", data); !errors.Is(err, ErrCallbackDataTooLong) {
+		t.Errorf("expected ErrCallbackDataTooLong without a store, got %v", err)
+	}
+}
+
+func TestMemoryCallbackStoreOverflow(t *testing.T) {
+	store := NewMemoryCallbackStore()
+
+	for i := 0; i <= callbackStoreMaxValues; i++ {
+		if err := store.Set(strconv.Itoa(i), "value"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, ok := store.Get("0"); ok {
+		t.Error("expected the oldest value to be evicted")
+	}
+
+	for _, key := range []string{"1", strconv.Itoa(callbackStoreMaxValues)} {
+		if value, ok := store.Get(key); !ok || value != "value" {
+			t.Errorf("expected the value of %s to be kept, got %q, %v", key, value, ok)
+		}
+	}
+	memory := store.(*memoryCallbackStore)
+
+	if len(memory.values) != callbackStoreMaxValues {
+		t.Errorf("expected %d values, got %d", callbackStoreMaxValues, len(memory.values))
+	}
+}
+
+func TestCallbackCodecErrors(t *testing.T) {
+	codec := NewCallbackCodec(nil)
+
+	for _, prefix := range []string{"", "a:b", "a|b", "a~b", "a#b", `a\b`} {
+		if _, err := codec.Encode(prefix, nil); !errors.Is(err, ErrCallbackPrefixInvalid) {
+			t.Errorf("%q: expected ErrCallbackPrefixInvalid, got %v", prefix, err)
+		}
+	}
+
+	if _, err := codec.Encode("p", 42); !errors.Is(err, ErrCallbackDataNotStruct) {
+		t.Errorf("expected ErrCallbackDataNotStruct, got %v", err)
+	}
+
+	if _, err := codec.Encode("p", struct{ Items []int }{}); !errors.Is(err, ErrCallbackFieldNotSimple) {
+		t.Errorf("expected ErrCallbackFieldNotSimple, got %v", err)
+	}
+	var decoded callbackTestData
+
+	for _, data := range []string{"no separator", "p:a|1|z!", "p:a|1|2|3|4|5"} {
+		if err := codec.Decode(data, &decoded); !errors.Is(err, ErrCallbackDataMalformed) {
+			t.Errorf("%q: expected ErrCallbackDataMalformed, got %v", data, err)
+		}
+	}
+}
+
+func TestOnCallbackFromHandler(t *testing.T) {
+	bot := Init("123:token")
+	bot.SetLogger(nil)
+	var called int64
+	registering := make(chan struct{})
+	registered := make(chan struct{})
+	bot.AddHandler(&Message{}, func(bot *GoBot, update *Update) {
+		close(registering)
+		defer close(registered)
+
+		for i := 0; i < 100; i++ {
+			bot.OnCallback("p"+strconv.Itoa(i), func(bot *GoBot, update *Update) {
+				atomic.AddInt64(&called, 1)
+			})
+			bot.AddHandler(&Poll{}, func(bot *GoBot, update *Update) {})
+			bot.Use(func(next UpdateHandler) UpdateHandler { return next })
+			runtime.Gosched()
+		}
+	})
+	go bot.HandleUpdate(&Update{Message: &Message{MessageId: 1, Chat: &Chat{Id: 1}}})
+	<-registering
+
+	// The updates are handled while the handler is registering, as the bot would with Loop
+	for i := 0; ; i++ {
+		select {
+		case <-registered:
+			bot.HandleUpdate(&Update{CallbackQuery: &CallbackQuery{Id: "q", Data: "p99:"}})
+
+			if atomic.LoadInt64(&called) != 1 {
+				t.Error("expected the callback registered by the handler to be called")
+			}
+			return
+		default:
+			bot.HandleUpdate(&Update{UpdateId: i, Poll: &Poll{Id: "poll"}})
+			runtime.Gosched()
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package gobot
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrChatIdInvalid = errors.New("invalid chat id, must be built with NewChatId or NewChatUsername")
+
+// ChatId is either the unique identifier of a chat or the username of a channel in the format @channelusername.
+// It's built with NewChatId or NewChatUsername, and nil means that no chat is specified.
+type ChatId struct {
+	id       int
+	username string
+}
+
+func NewChatId(id int) *ChatId {
+	return &ChatId{id: id}
+}
+
+func NewChatUsername(username string) *ChatId {
+	if !strings.HasPrefix(username, "@") {
+		username = "@" + username
+	}
+	return &ChatId{username: username}
+}
+
+// Id returns the unique identifier of the chat, if the ChatId isn't a username.
+func (chatId *ChatId) Id() (int, bool) {
+	if chatId == nil || chatId.username != "" || chatId.id == 0 {
+		return 0, false
+	}
+	return chatId.id, true
+}
+
+// Username returns the username of the channel, including the leading @, if the ChatId is a username.
+func (chatId *ChatId) Username() (string, bool) {
+	if chatId == nil || len(chatId.username) < 2 {
+		return "", false
+	}
+	return chatId.username, true
+}
+
+// String returns the identifier or the username, as sent to the Bot API.
+func (chatId *ChatId) String() string {
+	if id, ok := chatId.Id(); ok {
+		return strconv.Itoa(id)
+	}
+	username, _ := chatId.Username()
+	return username
+}
+
+func (chatId *ChatId) MarshalJSON() ([]byte, error) {
+	if id, ok := chatId.Id(); ok {
+		return []byte(strconv.Itoa(id)), nil
+	} else if username, ok := chatId.Username(); ok {
+		return json.Marshal(username)
+	}
+	return nil, ErrChatIdInvalid
+}
+
+func (chatId *ChatId) UnmarshalJSON(data []byte) error {
+	var id int
+
+	if err := json.Unmarshal(data, &id); err == nil {
+		*chatId = ChatId{id: id}
+		return nil
+	}
+	var username string
+
+	if err := json.Unmarshal(data, &username); err != nil {
+		return err
+	}
+
+	if id, err := strconv.Atoi(username); err == nil {
+		*chatId = ChatId{id: id}
+	} else {
+		*chatId = ChatId{username: username}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package gobot
+
+import (
+	"encoding/json"
+)
+
+// ChatMember is implemented by ChatMemberOwner, ChatMemberAdministrator, ChatMemberMember,
+// ChatMemberRestricted, ChatMemberLeft and ChatMemberBanned, and by ChatMemberUnknown for the statuses
+// added in newer versions of the Bot API.
+type ChatMember interface {
+	GetStatus() string
+	GetUser() *User
+	IsAdmin() bool     // True, if the member is the owner or an administrator of the chat
+	CanRestrict() bool // True, if the member can restrict, ban or unban chat members
+	IsInChat() bool    // True, if the user is currently a member of the chat
+}
+
+// ChatMemberUnknown is a chat member whose status isn't known to this version of the library. It isn't
+// considered an administrator nor in the chat, and the events of ChatMemberUpdated aren't reported for it.
+type ChatMemberUnknown struct {
+	Status string          `json:"status"`
+	User   *User           `json:"user"`
+	Raw    json.RawMessage `json:"-"` // The member as received, with the fields of its status
+}
+
+// MarshalJSON encodes the member as it was received.
+func (member *ChatMemberUnknown) MarshalJSON() ([]byte, error) {
+	return member.Raw, nil
+}
+
+func unmarshalChatMember(data []byte) (ChatMember, error) {
+	if string(data) == "null" {
+		return nil, nil
+	}
+	var status struct {
+		Status string `json:"status"`
+	}
+
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, err
+	}
+	var member ChatMember
+
+	switch status.Status {
+	case "creator":
+		member = &ChatMemberOwner{}
+	case "administrator":
+		member = &ChatMemberAdministrator{}
+	case "member":
+		member = &ChatMemberMember{}
+	case "restricted":
+		member = &ChatMemberRestricted{}
+	case "left":
+		member = &ChatMemberLeft{}
+	case "kicked":
+		member = &ChatMemberBanned{}
+	default:
+		unknown := &ChatMemberUnknown{Raw: append(json.RawMessage(nil), data...)}
+		return unknown, json.Unmarshal(data, unknown)
+	}
+	return member, json.Unmarshal(data, member)
+}
+
+func unmarshalChatMembers(data []byte) ([]ChatMember, error) {
+	var rawMembers []json.RawMessage
+
+	if err := json.Unmarshal(data, &rawMembers); err != nil {
+		return nil, err
+	}
+	members := make([]ChatMember, 0, len(rawMembers))
+
+	for _, rawMember := range rawMembers {
+		member, err := unmarshalChatMember(rawMember)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if member != nil {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (update *ChatMemberUpdated) UnmarshalJSON(data []byte) error {
+	type chatMemberUpdated ChatMemberUpdated
+	decoded := struct {
+		*chatMemberUpdated
+		OldChatMember json.RawMessage `json:"old_chat_member"`
+		NewChatMember json.RawMessage `json:"new_chat_member"`
+	}{chatMemberUpdated: (*chatMemberUpdated)(update)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+
+	if update.OldChatMember, err = unmarshalChatMember(decoded.OldChatMember); err != nil {
+		return err
+	}
+	update.NewChatMember, err = unmarshalChatMember(decoded.NewChatMember)
+	return err
+}
+
+// Joined reports whether the user wasn't in the chat and now is.
+func (update *ChatMemberUpdated) Joined() bool {
+	return update.known() && !update.OldChatMember.IsInChat() && update.NewChatMember.IsInChat()
+}
+
+// Left reports whether the user was in the chat and now isn't, either because they left or were banned.
+func (update *ChatMemberUpdated) Left() bool {
+	return update.known() && update.OldChatMember.IsInChat() && !update.NewChatMember.IsInChat()
+}
+
+// Banned reports whether the user has just been banned from the chat.
+func (update *ChatMemberUpdated) Banned() bool {
+	_, wasBanned := update.OldChatMember.(*ChatMemberBanned)
+	_, isBanned := update.NewChatMember.(*ChatMemberBanned)
+	return update.known() && !wasBanned && isBanned
+}
+
+// Promoted reports whether the user has just become an administrator of the chat.
+func (update *ChatMemberUpdated) Promoted() bool {
+	return update.known() && !update.OldChatMember.IsAdmin() && update.NewChatMember.IsAdmin()
+}
+
+// Demoted reports whether the user has just stopped being an administrator of the chat.
+func (update *ChatMemberUpdated) Demoted() bool {
+	return update.known() && update.OldChatMember.IsAdmin() && !update.NewChatMember.IsAdmin()
+}
+
+// Restricted reports whether the user has just been restricted in the chat.
+func (update *ChatMemberUpdated) Restricted() bool {
+	_, wasRestricted := update.OldChatMember.(*ChatMemberRestricted)
+	_, isRestricted := update.NewChatMember.(*ChatMemberRestricted)
+	return update.known() && !wasRestricted && isRestricted
+}
+
+// known reports whether both the old and the new member are set and have a known status, without which
+// nothing can be said about the change.
+func (update *ChatMemberUpdated) known() bool {
+	return knownChatMember(update.OldChatMember) && knownChatMember(update.NewChatMember)
+}
+
+func knownChatMember(member ChatMember) bool {
+	_, unknown := member.(*ChatMemberUnknown)
+	return member != nil && !unknown
+}
+
+func (member *ChatMemberOwner) GetStatus() string { return member.Status }
+func (member *ChatMemberOwner) GetUser() *User    { return member.User }
+func (member *ChatMemberOwner) IsAdmin() bool     { return true }
+func (member *ChatMemberOwner) CanRestrict() bool { return true }
+func (member *ChatMemberOwner) IsInChat() bool    { return true }
+
+func (member *ChatMemberAdministrator) GetStatus() string { return member.Status }
+func (member *ChatMemberAdministrator) GetUser() *User    { return member.User }
+func (member *ChatMemberAdministrator) IsAdmin() bool     { return true }
+func (member *ChatMemberAdministrator) CanRestrict() bool { return member.CanRestrictMembers }
+func (member *ChatMemberAdministrator) IsInChat() bool    { return true }
+
+func (member *ChatMemberMember) GetStatus() string { return member.Status }
+func (member *ChatMemberMember) GetUser() *User    { return member.User }
+func (member *ChatMemberMember) IsAdmin() bool     { return false }
+func (member *ChatMemberMember) CanRestrict() bool { return false }
+func (member *ChatMemberMember) IsInChat() bool    { return true }
+
+func (member *ChatMemberRestricted) GetStatus() string { return member.Status }
+func (member *ChatMemberRestricted) GetUser() *User    { return member.User }
+func (member *ChatMemberRestricted) IsAdmin() bool     { return false }
+func (member *ChatMemberRestricted) CanRestrict() bool { return false }
+func (member *ChatMemberRestricted) IsInChat() bool    { return member.IsMember }
+
+func (member *ChatMemberLeft) GetStatus() string { return member.Status }
+func (member *ChatMemberLeft) GetUser() *User    { return member.User }
+func (member *ChatMemberLeft) IsAdmin() bool     { return false }
+func (member *ChatMemberLeft) CanRestrict() bool { return false }
+func (member *ChatMemberLeft) IsInChat() bool    { return false }
+
+func (member *ChatMemberBanned) GetStatus() string { return member.Status }
+func (member *ChatMemberBanned) GetUser() *User    { return member.User }
+func (member *ChatMemberBanned) IsAdmin() bool     { return false }
+func (member *ChatMemberBanned) CanRestrict() bool { return false }
+func (member *ChatMemberBanned) IsInChat() bool    { return false }
+
+func (member *ChatMemberUnknown) GetStatus() string { return member.Status }
+func (member *ChatMemberUnknown) GetUser() *User    { return member.User }
+func (member *ChatMemberUnknown) IsAdmin() bool     { return false }
+func (member *ChatMemberUnknown) CanRestrict() bool { return false }
+func (member *ChatMemberUnknown) IsInChat() bool    { return false }
@@ -0,0 +1,130 @@
+package gobot
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalChatMember(t *testing.T) {
+	tests := []struct {
+		data     string
+		member   ChatMember
+		inChat   bool
+		isAdmin  bool
+		restrict bool
+	}{
+		{`{"status":"creator","user":{"id":1}}`, &ChatMemberOwner{}, true, true, true},
+		{`{"status":"administrator","user":{"id":1},"can_restrict_members":true}`, &ChatMemberAdministrator{}, true, true, true},
+		{`{"status":"member","user":{"id":1}}`, &ChatMemberMember{}, true, false, false},
+		{`{"status":"restricted","user":{"id":1},"is_member":true}`, &ChatMemberRestricted{}, true, false, false},
+		{`{"status":"restricted","user":{"id":1},"is_member":false}`, &ChatMemberRestricted{}, false, false, false},
+		{`{"status":"left","user":{"id":1}}`, &ChatMemberLeft{}, false, false, false},
+		{`{"status":"kicked","user":{"id":1}}`, &ChatMemberBanned{}, false, false, false},
+		{`{"status":"guest","user":{"id":1},"until_date":5}`, &ChatMemberUnknown{}, false, false, false},
+	}
+
+	for _, test := range tests {
+		member, err := unmarshalChatMember([]byte(test.data))
+
+		if err != nil {
+			t.Fatalf("%s: %v", test.data, err)
+		} else if reflect.TypeOf(member) != reflect.TypeOf(test.member) {
+			t.Fatalf("%s: expected a %T, got %T", test.data, test.member, member)
+		}
+
+		if member.GetUser() == nil || member.GetUser().Id != 1 {
+			t.Errorf("%s: expected the user 1, got %+v", test.data, member.GetUser())
+		}
+
+		if member.IsInChat() != test.inChat || member.IsAdmin() != test.isAdmin || member.CanRestrict() != test.restrict {
+			t.Errorf("%s: expected in chat %v, admin %v and can restrict %v", test.data, test.inChat, test.isAdmin, test.restrict)
+		}
+	}
+}
+
+func TestUnmarshalUnknownChatMember(t *testing.T) {
+	data := `{"status":"guest","user":{"id":1},"until_date":5}`
+	member, err := unmarshalChatMember([]byte(data))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown, ok := member.(*ChatMemberUnknown)
+
+	if !ok {
+		t.Fatalf("expected a ChatMemberUnknown, got %T", member)
+	} else if unknown.GetStatus() != "guest" {
+		t.Errorf("expected the status guest, got %q", unknown.GetStatus())
+	}
+	encoded, err := json.Marshal(unknown)
+
+	if err != nil {
+		t.Fatal(err)
+	} else if string(encoded) != data {
+		t.Errorf("expected the member to be encoded as received, got %s", encoded)
+	}
+	members, err := unmarshalChatMembers([]byte(`[{"status":"member","user":{"id":2}},` + data + `]`))
+
+	if err != nil {
+		t.Fatal(err)
+	} else if len(members) != 2 {
+		t.Errorf("expected 2 members, got %d", len(members))
+	}
+}
+
+func TestChatMemberUpdatedEvents(t *testing.T) {
+	member := `{"status":"member","user":{"id":1}}`
+	admin := `{"status":"administrator","user":{"id":1}}`
+	left := `{"status":"left","user":{"id":1}}`
+	banned := `{"status":"kicked","user":{"id":1}}`
+	restricted := `{"status":"restricted","user":{"id":1},"is_member":true}`
+	unknown := `{"status":"guest","user":{"id":1}}`
+	tests := []struct {
+		old, new string
+		events   []string
+	}{
+		{left, member, []string{"joined"}},
+		{member, left, []string{"left"}},
+		{member, banned, []string{"left", "banned"}},
+		{member, admin, []string{"promoted"}},
+		{admin, member, []string{"demoted"}},
+		{member, restricted, []string{"restricted"}},
+		{member, member, nil},
+		{unknown, member, nil},
+		{member, unknown, nil},
+		{unknown, admin, nil},
+		{"null", banned, nil},
+		{admin, "null", nil},
+	}
+
+	for _, test := range tests {
+		var update ChatMemberUpdated
+		data := `{"chat":{"id":1},"from":{"id":1},"date":1,"old_chat_member":` + test.old + `,"new_chat_member":` + test.new + `}`
+
+		if err := json.Unmarshal([]byte(data), &update); err != nil {
+			t.Fatalf("%s: %v", data, err)
+		}
+		var events []string
+
+		for _, event := range []struct {
+			name     string
+			happened bool
+		}{
+			{"joined", update.Joined()},
+			{"left", update.Left()},
+			{"banned", update.Banned()},
+			{"promoted", update.Promoted()},
+			{"demoted", update.Demoted()},
+			{"restricted", update.Restricted()},
+		} {
+			if event.happened {
+				events = append(events, event.name)
+			}
+		}
+
+		if !reflect.DeepEqual(events, test.events) {
+			t.Errorf("%s -> %s: expected %v, got %v", test.old, test.new, test.events, events)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package gobot
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time of the timers of the bot, namely the OnMediaGroup window and the
+// InlineHandler debounce and cache. The system clock is used unless another one is set with SetClock,
+// e.g. a FakeClock in tests or the one advanced by Replay.
+type Clock interface {
+	Now() time.Time
+	AfterFunc(d time.Duration, f func()) Timer // Calls f once d has elapsed
+}
+
+// Timer is a timer started by Clock.AfterFunc, as *time.Timer is.
+type Timer interface {
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// SetClock replaces the clock of the timers of the bot, nil to restore the system clock. It must be called
+// before the bot starts receiving updates.
+func (bot *GoBot) SetClock(clock Clock) {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	bot.clock = clock
+}
+
+func (bot *GoBot) Clock() Clock {
+	return bot.clock
+}
+
+// FakeClock is a Clock that only moves when advanced. The timers that become due are called one at a
+// time, in the order of their deadlines, by the goroutine that advances the clock.
+type FakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	f        func()
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (clock *FakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+func (clock *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	timer := &fakeTimer{clock: clock, f: f}
+	timer.Reset(d)
+	return timer
+}
+
+// Advance moves the clock forward by d, calling the timers that become due on the way.
+func (clock *FakeClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	target := clock.now.Add(d)
+
+	for len(clock.timers) > 0 && !clock.timers[0].deadline.After(target) {
+		timer := clock.timers[0]
+		clock.timers = clock.timers[1:]
+		clock.now = timer.deadline
+		clock.mutex.Unlock()
+		timer.f()
+		clock.mutex.Lock()
+	}
+	clock.now = target
+	clock.mutex.Unlock()
+}
+
+// Flush moves the clock forward until no timer is left, calling them all.
+func (clock *FakeClock) Flush() {
+	for {
+		clock.mutex.Lock()
+
+		if len(clock.timers) == 0 {
+			clock.mutex.Unlock()
+			return
+		}
+		d := clock.timers[0].deadline.Sub(clock.now)
+		clock.mutex.Unlock()
+		clock.Advance(d)
+	}
+}
+
+// remove unschedules the timer, reporting whether it was scheduled. The caller must hold the mutex.
+func (clock *FakeClock) remove(timer *fakeTimer) bool {
+	for i, scheduled := range clock.timers {
+		if scheduled == timer {
+			clock.timers = append(clock.timers[:i], clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (timer *fakeTimer) Stop() bool {
+	timer.clock.mutex.Lock()
+	defer timer.clock.mutex.Unlock()
+	return timer.clock.remove(timer)
+}
+
+func (timer *fakeTimer) Reset(d time.Duration) bool {
+	clock := timer.clock
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	active := clock.remove(timer)
+	timer.deadline = clock.now.Add(d)
+	// Timers with the same deadline fire in the order they were scheduled
+	i := sort.Search(len(clock.timers), func(i int) bool {
+		return clock.timers[i].deadline.After(timer.deadline)
+	})
+	clock.timers = append(clock.timers, nil)
+	copy(clock.timers[i+1:], clock.timers[i:])
+	clock.timers[i] = timer
+	return active
+}
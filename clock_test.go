@@ -0,0 +1,116 @@
+package gobot
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	var fired []string
+	fire := func(name string) func() {
+		return func() {
+			fired = append(fired, name+"@"+clock.Now().Sub(start).String())
+		}
+	}
+	clock.AfterFunc(3*time.Second, fire("c"))
+	clock.AfterFunc(time.Second, fire("a"))
+	stopped := clock.AfterFunc(2*time.Second, fire("stopped"))
+	reset := clock.AfterFunc(time.Second, fire("reset"))
+	clock.AfterFunc(time.Second, fire("b"))
+
+	if !stopped.Stop() || stopped.Stop() {
+		t.Error("expected Stop to report whether the timer was scheduled")
+	}
+
+	if !reset.Reset(5 * time.Second) {
+		t.Error("expected Reset to report that the timer was scheduled")
+	}
+	clock.Advance(3 * time.Second)
+
+	if expected := []string{"a@1s", "b@1s", "c@3s"}; !reflect.DeepEqual(fired, expected) {
+		t.Errorf("expected %v, got %v", expected, fired)
+	}
+
+	if now := clock.Now(); !now.Equal(start.Add(3 * time.Second)) {
+		t.Errorf("expected the clock to be advanced by 3s, got %v", now.Sub(start))
+	}
+	clock.Flush()
+
+	if last := fired[len(fired)-1]; last != "reset@5s" {
+		t.Errorf("expected the reset timer to fire at 5s, got %v", fired)
+	}
+}
+
+func TestMediaGroupFakeClock(t *testing.T) {
+	var requests []interface{}
+	bot := recordingBot(&requests)
+	clock := NewFakeClock(time.Now())
+	bot.SetClock(clock)
+	var groups []*MediaGroup
+	bot.OnMediaGroup(time.Second, func(bot *GoBot, group *MediaGroup) {
+		groups = append(groups, group)
+	})
+	photo := func(id int) *Update {
+		return &Update{UpdateId: id, Message: &Message{MessageId: id, Chat: &Chat{Id: 1}, MediaGroupId: "album"}}
+	}
+	bot.HandleUpdate(photo(2))
+	clock.Advance(900 * time.Millisecond)
+	bot.HandleUpdate(photo(1))
+	clock.Advance(900 * time.Millisecond)
+
+	if len(groups) != 0 {
+		t.Fatal("expected the window to be restarted by the second item")
+	}
+	clock.Advance(100 * time.Millisecond)
+
+	if len(groups) != 1 || len(groups[0].Messages) != 2 || groups[0].Messages[0].MessageId != 1 {
+		t.Fatalf("expected one group of two sorted messages, got %+v", groups)
+	} else if depth := bot.QueueDepth(); depth != 0 {
+		t.Errorf("expected the queue to be empty, got %d", depth)
+	}
+}
+
+func TestReplayDrivesClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := func(id int, at time.Duration) *Record {
+		return &Record{Time: start.Add(at), Update: &Update{UpdateId: id, InlineQuery: &InlineQuery{
+			Id:    string(rune('a' + id)),
+			From:  &User{Id: 1},
+			Query: string(rune('a' + id)),
+		}}}
+	}
+	replay := &Replay{Records: []*Record{
+		query(0, 0),
+		query(1, 200*time.Millisecond),
+		query(2, 2*time.Second),
+		query(3, 2*time.Second+300*time.Millisecond),
+	}}
+	var answered []string
+	bot := Init("123:token")
+	bot.SetLogger(nil)
+	bot.SetTransport(TransportFunc(func(method string, params interface{}) (json.RawMessage, error) {
+		answered = append(answered, params.(AnswerInlineQueryParams).InlineQueryId)
+		return json.RawMessage("true"), nil
+	}))
+	bot.OnInlineQuery(&InlineHandler{
+		Debounce: 500 * time.Millisecond,
+		Results: func(bot *GoBot, query *InlineQuery) ([]InlineQueryResult, error) {
+			return nil, nil
+		},
+	})
+	previous := bot.Clock()
+	replay.Run(bot)
+
+	// The queries typed within the debounce are dropped as they were when recorded, whatever the Speed
+	if expected := []string{"b", "d"}; !reflect.DeepEqual(answered, expected) {
+		t.Errorf("expected the answers %v, got %v", expected, answered)
+	}
+
+	if bot.Clock() != previous {
+		t.Error("expected the clock of the bot to be restored")
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// bootstrap builds the spec from the hand-written types.go, methods.go and unions.go in dir, so that
+// generating the files from it reproduces them.
+func bootstrap(dir string) (*Spec, error) {
+	fileSet := token.NewFileSet()
+	spec := &Spec{}
+	parse := func(name string) (*ast.File, error) {
+		return parser.ParseFile(fileSet, filepath.Join(dir, name), nil, parser.ParseComments)
+	}
+	typesFile, err := parse("types.go")
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, decl := range typesFile.Decls {
+		// UnmarshalJSON methods are generated from the fields
+		if function, ok := decl.(*ast.FuncDecl); ok && function.Name.Name == "UnmarshalJSON" {
+			continue
+		}
+
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT {
+			continue
+		}
+		name, structType, ok := structDecl(decl)
+
+		if !ok {
+			return nil, fmt.Errorf("types.go: unexpected declaration at %s", fileSet.Position(decl.Pos()))
+		}
+		fields, err := bootstrapFields(fileSet, structType)
+
+		if err != nil {
+			return nil, fmt.Errorf("types.go: %s: %w", name, err)
+		}
+		spec.Types = append(spec.Types, &Type{Name: name, Fields: fields})
+	}
+	methodsFile, err := parse("methods.go")
+
+	if err != nil {
+		return nil, err
+	}
+	params := map[string][]*Field{}
+
+	for _, decl := range methodsFile.Decls {
+		if name, structType, ok := structDecl(decl); ok {
+			if params[name], err = bootstrapFields(fileSet, structType); err != nil {
+				return nil, fmt.Errorf("methods.go: %s: %w", name, err)
+			}
+			continue
+		}
+		function, ok := decl.(*ast.FuncDecl)
+
+		if !ok {
+			continue
+		}
+		method, err := bootstrapMethod(fileSet, function)
+
+		if err != nil {
+			return nil, fmt.Errorf("methods.go: %s: %w", function.Name.Name, err)
+		}
+
+		if function.Type.Params.NumFields() > 0 {
+			method.Params = params[function.Name.Name+"Params"]
+
+			if method.Params == nil {
+				method.Params = []*Field{}
+			}
+		}
+		spec.Methods = append(spec.Methods, method)
+	}
+	unionsFile, err := parse("unions.go")
+
+	if err != nil {
+		return nil, err
+	}
+	return spec, bootstrapUnions(spec, unionsFile)
+}
+
+func structDecl(decl ast.Decl) (string, *ast.StructType, bool) {
+	genDecl, ok := decl.(*ast.GenDecl)
+
+	if !ok || genDecl.Tok != token.TYPE || len(genDecl.Specs) != 1 {
+		return "", nil, false
+	}
+	typeSpec := genDecl.Specs[0].(*ast.TypeSpec)
+	structType, ok := typeSpec.Type.(*ast.StructType)
+	return typeSpec.Name.Name, structType, ok
+}
+
+func bootstrapFields(fileSet *token.FileSet, structType *ast.StructType) ([]*Field, error) {
+	fields := []*Field{}
+
+	for _, astField := range structType.Fields.List {
+		if len(astField.Names) != 1 || astField.Tag == nil {
+			return nil, fmt.Errorf("field without name or tag at %s", fileSet.Position(astField.Pos()))
+		}
+		tag, err := strconv.Unquote(astField.Tag.Value)
+
+		if err != nil {
+			return nil, err
+		}
+		jsonTag := reflect.StructTag(tag).Get("json")
+		field := &Field{
+			Json:     strings.TrimSuffix(jsonTag, ",omitempty"),
+			Type:     nodeString(fileSet, astField.Type),
+			Validate: reflect.StructTag(tag).Get("validate"),
+		}
+		field.Optional = field.Json != jsonTag
+
+		if name := astField.Names[0].Name; name != goName(field.Json) {
+			field.Name = name
+		}
+
+		if astField.Comment != nil {
+			field.Description = strings.TrimPrefix(strings.TrimPrefix(astField.Comment.List[0].Text, "//"), " ")
+		}
+
+		if field.Optional {
+			if !strings.HasPrefix(field.Description, "Optional.") {
+				return nil, fmt.Errorf("optional field %s isn't documented as such", field.Json)
+			}
+			field.Description = strings.TrimSpace(strings.TrimPrefix(field.Description, "Optional."))
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func bootstrapMethod(fileSet *token.FileSet, function *ast.FuncDecl) (*Method, error) {
+	method := &Method{}
+	results := function.Type.Results
+
+	if results == nil || len(results.List) != 2 {
+		return nil, fmt.Errorf("unexpected results")
+	}
+	method.Returns = nodeString(fileSet, results.List[0].Type)
+
+	ast.Inspect(function.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		if selector, ok := call.Fun.(*ast.SelectorExpr); ok && selector.Sel.Name == "Request" {
+			method.Name, _ = strconv.Unquote(call.Args[0].(*ast.BasicLit).Value)
+		} else if ident, ok := call.Fun.(*ast.Ident); ok && strings.HasPrefix(ident.Name, "unmarshal") {
+			method.Unmarshal = ident.Name
+		}
+		return true
+	})
+
+	if method.Name == "" || method.GoName() != function.Name.Name {
+		return nil, fmt.Errorf("can't find the Bot API method")
+	}
+	return method, nil
+}
+
+func bootstrapUnions(spec *Spec, file *ast.File) error {
+	unions := map[string]*Union{}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			typeSpec := decl.Specs[0].(*ast.TypeSpec)
+			union := &Union{Name: typeSpec.Name.Name, Doc: strings.TrimSuffix(decl.Doc.Text(), "\n")}
+			unions[union.marker()] = union
+			spec.Unions = append(spec.Unions, union)
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				for _, union := range spec.Unions {
+					if decl.Name.Name == union.unmarshaler() {
+						union.Unmarshal = true
+					}
+				}
+				continue
+			}
+			receiver := decl.Recv.List[0]
+			memberType := receiver.Type.(*ast.StarExpr).X.(*ast.Ident).Name
+
+			if union, ok := unions[decl.Name.Name]; ok {
+				union.Members = append(union.Members, &UnionMember{Type: memberType})
+				continue
+			}
+
+			if decl.Name.Name != "MarshalJSON" {
+				return fmt.Errorf("unions.go: unexpected method %s", decl.Name.Name)
+			}
+			call := decl.Body.List[1].(*ast.ReturnStmt).Results[0].(*ast.CallExpr)
+			discriminator, _ := strconv.Unquote(call.Args[0].(*ast.BasicLit).Value)
+			value, _ := strconv.Unquote(call.Args[1].(*ast.BasicLit).Value)
+
+			for _, union := range spec.Unions {
+				members := union.Members
+
+				if len(members) > 0 && members[len(members)-1].Type == memberType {
+					union.Discriminator = discriminator
+					union.Receiver = receiver.Names[0].Name
+					members[len(members)-1].Value = value
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func nodeString(fileSet *token.FileSet, node ast.Node) string {
+	var buffer bytes.Buffer
+	_ = printer.Fprint(&buffer, fileSet, node)
+	return buffer.String()
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// packages maps the qualifiers that may appear in the field types to their import paths.
+var packages = map[string]string{
+	"json.": "encoding/json",
+}
+
+// generate returns the content of the generated files, keyed by their name.
+func generate(spec *Spec) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	generators := map[string]func(*Spec, *bytes.Buffer){
+		"types.go":   generateTypes,
+		"methods.go": generateMethods,
+		"unions.go":  generateUnions,
+	}
+
+	for name, generator := range generators {
+		var body bytes.Buffer
+		generator(spec, &body)
+		header := "// Code generated by gobot-gen from Bot API " + spec.Version + ". DO NOT EDIT.\n\npackage gobot\n"
+		source := []byte(header + imports(body.String()) + body.String())
+		formatted, err := format.Source(source)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		files[name] = formatted
+	}
+	return files, nil
+}
+
+// imports returns the import declaration needed by the code.
+func imports(code string) string {
+	var paths []string
+
+	for qualifier, path := range packages {
+		if strings.Contains(code, qualifier) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	switch len(paths) {
+	case 0:
+		return ""
+	case 1:
+		return "\nimport \"" + paths[0] + "\"\n"
+	}
+	return "\nimport (\n\t\"" + strings.Join(paths, "\"\n\t\"") + "\"\n)\n"
+}
+
+func generateTypes(spec *Spec, out *bytes.Buffer) {
+	unions := map[string]*Union{}
+
+	for _, union := range spec.Unions {
+		if union.Unmarshal {
+			unions[union.Name] = union
+		}
+	}
+
+	for _, t := range spec.Types {
+		writeStruct(out, t.Name, t.Fields)
+		writeUnmarshalJSON(out, t, unions)
+	}
+}
+
+// writeUnmarshalJSON writes the UnmarshalJSON method of a type having fields whose type is a union,
+// which can't be decoded by encoding/json.
+func writeUnmarshalJSON(out *bytes.Buffer, t *Type, unions map[string]*Union) {
+	var fields []*Field
+
+	for _, field := range t.Fields {
+		if _, ok := unions[strings.TrimPrefix(field.Type, "[]")]; ok {
+			fields = append(fields, field)
+		}
+	}
+
+	if len(fields) == 0 {
+		return
+	}
+	receiver := strings.ToLower(t.Name[:1]) + t.Name[1:]
+	fmt.Fprintf(out, "\nfunc (%s *%s) UnmarshalJSON(data []byte) error {\n", receiver, t.Name)
+	fmt.Fprintf(out, "\ttype plain %s\n\tdecoded := struct {\n\t\t*plain\n", t.Name)
+
+	for _, field := range fields {
+		fmt.Fprintf(out, "\t\t%s json.RawMessage `json:\"%s\"`\n", field.GoName(), field.Json)
+	}
+	fmt.Fprintf(out, "\t}{plain: (*plain)(%s)}\n\n", receiver)
+	out.WriteString("\tif err := json.Unmarshal(data, &decoded); err != nil {\n\t\treturn err\n\t}\n\tvar err error\n")
+
+	for i, field := range fields {
+		unmarshaler := unions[strings.TrimPrefix(field.Type, "[]")].unmarshaler()
+
+		if strings.HasPrefix(field.Type, "[]") {
+			unmarshaler += "s"
+		}
+		assignment := fmt.Sprintf("%s.%s, err = %s(decoded.%s)", receiver, field.GoName(), unmarshaler, field.GoName())
+
+		if i == len(fields)-1 {
+			fmt.Fprintf(out, "\t%s\n\treturn err\n}\n", assignment)
+		} else {
+			fmt.Fprintf(out, "\n\tif %s; err != nil {\n\t\treturn err\n\t}\n", assignment)
+		}
+	}
+}
+
+func writeStruct(out *bytes.Buffer, name string, fields []*Field) {
+	fmt.Fprintf(out, "\ntype %s struct {\n", name)
+
+	for _, field := range fields {
+		tag := field.Json
+		comment := field.Description
+
+		if field.Optional {
+			tag += ",omitempty"
+			comment = strings.TrimSpace("Optional. " + comment)
+		}
+		tag = `json:"` + tag + `"`
+
+		if field.Validate != "" {
+			tag += ` validate:"` + field.Validate + `"`
+		}
+		fmt.Fprintf(out, "\t%s %s `%s`", field.GoName(), field.Type, tag)
+
+		if comment != "" {
+			fmt.Fprintf(out, " // %s", comment)
+		}
+		out.WriteString("\n")
+	}
+	out.WriteString("}\n")
+}
+
+func generateMethods(spec *Spec, out *bytes.Buffer) {
+	for _, method := range spec.Methods {
+		name := method.GoName()
+		signature := "()"
+		params := "nil"
+
+		if method.Params != nil {
+			writeStruct(out, name+"Params", method.Params)
+			signature = "(params " + name + "Params)"
+			params = "params"
+		}
+		fmt.Fprintf(out, "\nfunc (bot GoBot) %s%s (%s, error) {\n", name, signature, method.Returns)
+
+		if method.Returns == "bool" {
+			fmt.Fprintf(out, "\t_, err := bot.Request(%q, %s)\n\n", method.Name, params)
+			out.WriteString("\tif err != nil {\n\t\treturn false, err\n\t}\n\treturn true, nil\n}\n")
+			continue
+		}
+		fmt.Fprintf(out, "\tresponse, err := bot.Request(%q, %s)\n\n", method.Name, params)
+		fmt.Fprintf(out, "\tif err != nil {\n\t\treturn %s, err\n\t}\n", zeroValue(method.Returns))
+
+		if method.Unmarshal != "" {
+			fmt.Fprintf(out, "\treturn %s(response)\n}\n", method.Unmarshal)
+			continue
+		}
+		fmt.Fprintf(out, "\tvar parsedResponse %s\n", method.Returns)
+		out.WriteString("\treturn parsedResponse, json.Unmarshal(response, &parsedResponse)\n}\n")
+	}
+}
+
+func zeroValue(goType string) string {
+	switch goType {
+	case "bool":
+		return "false"
+	case "string":
+		return `""`
+	case "int", "int64", "float64":
+		return "0"
+	}
+	return "nil"
+}
+
+func generateUnions(spec *Spec, out *bytes.Buffer) {
+	discriminated := false
+
+	for _, union := range spec.Unions {
+		out.WriteString("\n")
+
+		for _, line := range strings.Split(union.Doc, "\n") {
+			fmt.Fprintf(out, "// %s\n", line)
+		}
+		fmt.Fprintf(out, "type %s interface {\n\t%s()\n}\n", union.Name, union.marker())
+		discriminated = discriminated || union.Discriminator != ""
+	}
+
+	if discriminated {
+		out.WriteString(marshalWithDiscriminator)
+	}
+
+	for _, union := range spec.Unions {
+		if union.Unmarshal {
+			writeUnmarshaler(out, union, usesSlice(spec, union))
+		}
+
+		for _, member := range union.Members {
+			fmt.Fprintf(out, "\nfunc (*%s) %s() {}\n", member.Type, union.marker())
+
+			if union.Discriminator == "" {
+				continue
+			}
+			fmt.Fprintf(out, "\nfunc (%s *%s) MarshalJSON() ([]byte, error) {\n", union.Receiver, member.Type)
+			fmt.Fprintf(out, "\ttype plain %s\n", member.Type)
+			fmt.Fprintf(out, "\treturn marshalWithDiscriminator(%q, %q, (*plain)(%s))\n}\n", union.Discriminator, member.Value, union.Receiver)
+		}
+	}
+}
+
+// usesSlice reports whether a field of a type or the result of a method is a slice of the union.
+func usesSlice(spec *Spec, union *Union) bool {
+	for _, t := range spec.Types {
+		for _, field := range t.Fields {
+			if field.Type == "[]"+union.Name {
+				return true
+			}
+		}
+	}
+
+	for _, method := range spec.Methods {
+		if method.Returns == "[]"+union.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// writeUnmarshaler writes the functions decoding a value of the union, and a slice of them if needed,
+// choosing the member from the discriminator.
+func writeUnmarshaler(out *bytes.Buffer, union *Union, slice bool) {
+	fmt.Fprintf(out, "\nfunc %s(data []byte) (%s, error) {\n", union.unmarshaler(), union.Name)
+	out.WriteString("\tif len(data) == 0 || string(data) == \"null\" {\n\t\treturn nil, nil\n\t}\n")
+	fmt.Fprintf(out, "\tvar discriminator struct {\n\t\t%s string `json:\"%s\"`\n\t}\n\n", goName(union.Discriminator), union.Discriminator)
+	out.WriteString("\tif err := json.Unmarshal(data, &discriminator); err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(out, "\tvar value %s\n\n\tswitch discriminator.%s {\n", union.Name, goName(union.Discriminator))
+
+	for _, member := range union.Members {
+		fmt.Fprintf(out, "\tcase %q:\n\t\tvalue = &%s{}\n", member.Value, member.Type)
+	}
+	out.WriteString("\tdefault:\n\t\t// Values added in newer versions of the Bot API are skipped instead of failing the whole response\n")
+	out.WriteString("\t\treturn nil, nil\n\t}\n\treturn value, json.Unmarshal(data, value)\n}\n")
+
+	if !slice {
+		return
+	}
+	fmt.Fprintf(out, "\nfunc %ss(data []byte) ([]%s, error) {\n", union.unmarshaler(), union.Name)
+	out.WriteString("\tif len(data) == 0 || string(data) == \"null\" {\n\t\treturn nil, nil\n\t}\n")
+	out.WriteString("\tvar rawValues []json.RawMessage\n\n")
+	out.WriteString("\tif err := json.Unmarshal(data, &rawValues); err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(out, "\tvalues := make([]%s, 0, len(rawValues))\n\n", union.Name)
+	out.WriteString("\tfor _, rawValue := range rawValues {\n")
+	fmt.Fprintf(out, "\t\tvalue, err := %s(rawValue)\n\n", union.unmarshaler())
+	out.WriteString("\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\n")
+	out.WriteString("\t\tif value != nil {\n\t\t\tvalues = append(values, value)\n\t\t}\n\t}\n\treturn values, nil\n}\n")
+}
+
+const marshalWithDiscriminator = `
+// marshalWithDiscriminator marshals value and adds the discriminator field in front of the other fields.
+func marshalWithDiscriminator(field string, discriminator string, value interface{}) ([]byte, error) {
+	encodedValue, err := json.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+	encoded := []byte(` + "`" + `{"` + "`" + ` + field + ` + "`" + `":"` + "`" + ` + discriminator + ` + "`" + `"` + "`" + `)
+
+	if len(encodedValue) > 2 {
+		encoded = append(encoded, ',')
+	}
+	return append(encoded, encodedValue[1:]...), nil
+}
+`
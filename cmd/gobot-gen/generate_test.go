@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func TestGenerateGolden(t *testing.T) {
+	spec, err := loadSpec(filepath.Join("testdata", "spec.json"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(spec)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, generated := range files {
+		golden := filepath.Join("testdata", name+".golden")
+
+		if *update {
+			if err := ioutil.WriteFile(golden, generated, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		expected, err := ioutil.ReadFile(golden)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(generated, expected) {
+			t.Errorf("%s differs from %s, run go test -update if the change is expected:\n%s", name, golden, generated)
+		}
+	}
+}
+
+// TestCommittedSources checks that the files of the package are the ones generated from api.json.
+func TestCommittedSources(t *testing.T) {
+	if err := run("api.json", filepath.Join("..", ".."), true, false); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,89 @@
+// Command gobot-gen generates types.go, methods.go and unions.go of gobot from a JSON description of
+// the Bot API. It's run from the root of the module with go generate:
+//
+//	go run ./cmd/gobot-gen              regenerates the files from cmd/gobot-gen/api.json
+//	go run ./cmd/gobot-gen -check       fails if the files differ from what the spec generates
+//	go run ./cmd/gobot-gen -bootstrap   rewrites the spec from the current files
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	specPath := flag.String("spec", filepath.Join("cmd", "gobot-gen", "api.json"), "path of the JSON spec")
+	dir := flag.String("dir", ".", "directory of the gobot package")
+	check := flag.Bool("check", false, "check that the files are up to date instead of writing them")
+	bootstrapSpec := flag.Bool("bootstrap", false, "write the spec from the current files")
+	flag.Parse()
+
+	if err := run(*specPath, *dir, *check, *bootstrapSpec); err != nil {
+		fmt.Fprintln(os.Stderr, "gobot-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath string, dir string, check bool, bootstrapSpec bool) error {
+	if bootstrapSpec {
+		spec, err := bootstrap(dir)
+
+		if err != nil {
+			return err
+		}
+
+		if previous, err := loadSpec(specPath); err == nil {
+			spec.Version = previous.Version
+		}
+		encoded, err := spec.encode()
+
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(specPath, encoded, 0644)
+	}
+	spec, err := loadSpec(specPath)
+
+	if err != nil {
+		return err
+	}
+	files, err := generate(spec)
+
+	if err != nil {
+		return err
+	}
+	var names []string
+
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var stale []string
+
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+
+		if check {
+			current, err := ioutil.ReadFile(path)
+
+			if err != nil || !bytes.Equal(current, files[name]) {
+				stale = append(stale, path)
+			}
+			continue
+		}
+
+		if err := ioutil.WriteFile(path, files[name], 0644); err != nil {
+			return err
+		}
+	}
+
+	if len(stale) > 0 {
+		return fmt.Errorf("%v out of date, run go generate", stale)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+)
+
+// Spec is the machine-readable description of the Bot API from which methods.go, types.go and
+// unions.go are generated.
+type Spec struct {
+	Version string    `json:"version"`
+	Types   []*Type   `json:"types"`
+	Methods []*Method `json:"methods"`
+	Unions  []*Union  `json:"unions"`
+}
+
+// Type is an object returned by the Bot API or passed to it.
+type Type struct {
+	Name   string   `json:"name"`
+	Fields []*Field `json:"fields"`
+}
+
+// Field is a field of a type or a param of a method.
+type Field struct {
+	Name        string `json:"name,omitempty"` // Go name, when it differs from the one derived from Json
+	Json        string `json:"json"`
+	Type        string `json:"type"` // Go type
+	Optional    bool   `json:"optional,omitempty"`
+	Validate    string `json:"validate,omitempty"` // Constraints checked by the validator, e.g. "len=1-4096"
+	Description string `json:"description"`
+}
+
+// Method is a method of the Bot API. Methods without params are called without a Params struct.
+type Method struct {
+	Name      string   `json:"name"`
+	Params    []*Field `json:"params,omitempty"`
+	Returns   string   `json:"returns"`             // Go type of the result
+	Unmarshal string   `json:"unmarshal,omitempty"` // Function decoding the result, for interface results
+}
+
+// Union is a set of types that can be passed where the Bot API accepts one of them. Its members
+// implement an interface with an unexported marker method and, if the union has a discriminator,
+// marshal themselves with it.
+type Union struct {
+	Name          string         `json:"name"`
+	Doc           string         `json:"doc"`
+	Discriminator string         `json:"discriminator,omitempty"` // JSON field telling the members apart
+	Receiver      string         `json:"receiver,omitempty"`      // Receiver name of the members' MarshalJSON
+	Unmarshal     bool           `json:"unmarshal,omitempty"`     // Whether the union is returned by the Bot API and needs to be decoded
+	Members       []*UnionMember `json:"members"`
+}
+
+type UnionMember struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"` // Value of the discriminator
+}
+
+func loadSpec(path string) (*Spec, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+	var spec *Spec
+	return spec, json.Unmarshal(data, &spec)
+}
+
+// encode returns the spec as indented JSON, with HTML characters unescaped so that it stays readable.
+func (spec *Spec) encode() ([]byte, error) {
+	var builder strings.Builder
+	encoder := json.NewEncoder(&builder)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(spec); err != nil {
+		return nil, err
+	}
+	return []byte(builder.String()), nil
+}
+
+// GoName returns the name of the field in the generated struct.
+func (field *Field) GoName() string {
+	if field.Name != "" {
+		return field.Name
+	}
+	return goName(field.Json)
+}
+
+// goName converts a snake_case Bot API name to the CamelCase name used in Go, e.g. "chat_id" to "ChatId".
+func goName(name string) string {
+	var builder strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			builder.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return builder.String()
+}
+
+// GoName returns the name of the method on GoBot, e.g. "SendMessage".
+func (method *Method) GoName() string {
+	return strings.ToUpper(method.Name[:1]) + method.Name[1:]
+}
+
+// unmarshaler returns the name of the function decoding a value of the union, e.g. "unmarshalMenuButton".
+func (union *Union) unmarshaler() string {
+	return "unmarshal" + union.Name
+}
+
+// marker returns the name of the marker method of the union, e.g. "replyMarkup".
+func (union *Union) marker() string {
+	return strings.ToLower(union.Name[:1]) + union.Name[1:]
+}
@@ -0,0 +1,195 @@
+package gobot
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DryRun is a transport that executes only the read-only methods, whose name starts with "get", and
+// logs the others, answering them with synthesized results, e.g. to run a new version of a bot against
+// production traffic without it acting on the chats.
+type DryRun struct {
+	Next     Transport                                   // Transport executing the read-only methods
+	ReadOnly func(method string) bool                    // Optional. Reports whether the method is executed, instead of the default prefix check
+	Log      func(method string, params json.RawMessage) // Optional. Called with every skipped request, instead of logging it
+	Logger   Logger                                      // Logger of the skipped requests, the one of the bot with bot.DryRun
+	mutex    sync.Mutex
+	lastId   int
+}
+
+func NewDryRun(next Transport) *DryRun {
+	return &DryRun{Next: next, Logger: NewStdLogger(log.Default(), false)}
+}
+
+// DryRun makes the bot run in dry-run mode, wrapping its current transport.
+func (bot *GoBot) DryRun() *DryRun {
+	dryRun := NewDryRun(bot.Transport())
+	dryRun.Logger = bot.logger
+	bot.SetTransport(dryRun)
+	return dryRun
+}
+
+func IsReadOnlyMethod(method string) bool {
+	return strings.HasPrefix(method, "get")
+}
+
+func (dryRun *DryRun) Do(method string, params interface{}) (json.RawMessage, error) {
+	readOnly := dryRun.ReadOnly
+
+	if readOnly == nil {
+		readOnly = IsReadOnlyMethod
+	}
+
+	if readOnly(method) {
+		return dryRun.Next.Do(method, params)
+	}
+	encoded, err := json.Marshal(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun.Log != nil {
+		dryRun.Log(method, encoded)
+	} else {
+		dryRun.Logger.Info("Dry run", "method", method, "params", string(encoded))
+	}
+	return json.Marshal(dryRun.synthesize(method, encoded))
+}
+
+// dryRunParams holds the params needed to synthesize the results.
+type dryRunParams struct {
+	ChatId          *ChatId               `json:"chat_id"`
+	MessageThreadId int                   `json:"message_thread_id"`
+	MessageId       int                   `json:"message_id"`
+	MessageIds      []int                 `json:"message_ids"`
+	InlineMessageId string                `json:"inline_message_id"`
+	Text            string                `json:"text"`
+	Entities        []*MessageEntity      `json:"entities"`
+	Caption         string                `json:"caption"`
+	CaptionEntities []*MessageEntity      `json:"caption_entities"`
+	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup"`
+	Media           json.RawMessage       `json:"media"`
+	Name            string                `json:"name"`
+	ExpireDate      int                   `json:"expire_date"`
+	MemberLimit     int                   `json:"member_limit"`
+	InviteLink      string                `json:"invite_link"`
+	IconColor       int                   `json:"icon_color"`
+}
+
+// synthesize returns a plausible result for the method: new messages get increasing ids in the chat.
+func (dryRun *DryRun) synthesize(method string, encoded json.RawMessage) interface{} {
+	var params dryRunParams
+	_ = json.Unmarshal(encoded, &params)
+
+	switch method {
+	case "sendMessage", "sendPhoto", "sendAudio", "sendDocument", "sendVideo", "sendAnimation", "sendVoice",
+		"sendVideoNote", "sendPaidMedia", "sendLocation", "sendVenue", "sendContact", "sendPoll", "sendDice", "sendSticker",
+		"sendInvoice", "sendGame", "forwardMessage":
+		return dryRun.message(&params, dryRun.nextId())
+	case "copyMessage":
+		return &MessageId{MessageId: dryRun.nextId()}
+	case "forwardMessages", "copyMessages":
+		messageIds := []*MessageId{}
+
+		for range params.MessageIds {
+			messageIds = append(messageIds, &MessageId{MessageId: dryRun.nextId()})
+		}
+		return messageIds
+	case "sendMediaGroup":
+		var media []json.RawMessage
+		_ = json.Unmarshal(params.Media, &media)
+		messages := []*Message{}
+		groupId := strconv.Itoa(dryRun.nextId())
+
+		for _, item := range media {
+			var itemParams dryRunParams
+			_ = json.Unmarshal(item, &itemParams)
+			itemParams.ChatId = params.ChatId
+			message := dryRun.message(&itemParams, dryRun.nextId())
+			message.MediaGroupId = groupId
+			messages = append(messages, message)
+		}
+		return messages
+	case "editMessageText", "editMessageCaption", "editMessageMedia", "editMessageReplyMarkup",
+		"editMessageLiveLocation", "stopMessageLiveLocation", "setGameScore":
+		if params.InlineMessageId != "" {
+			return true
+		}
+		message := dryRun.message(&params, params.MessageId)
+		message.EditDate = message.Date
+		return message
+	case "stopPoll":
+		return &Poll{Id: strconv.Itoa(dryRun.nextId()), IsClosed: true}
+	case "exportChatInviteLink":
+		return "https://t.me/+dryrun" + strconv.Itoa(dryRun.nextId())
+	case "createChatInviteLink", "editChatInviteLink", "revokeChatInviteLink", "createChatSubscriptionInviteLink",
+		"editChatSubscriptionInviteLink":
+		link := &ChatInviteLink{
+			InviteLink:  params.InviteLink,
+			Creator:     &User{IsBot: true},
+			IsRevoked:   method == "revokeChatInviteLink",
+			ExpireDate:  params.ExpireDate,
+			MemberLimit: params.MemberLimit,
+		}
+
+		if link.InviteLink == "" {
+			link.InviteLink = "https://t.me/+dryrun" + strconv.Itoa(dryRun.nextId())
+		}
+		return link
+	case "uploadStickerFile":
+		fileId := "dryrun" + strconv.Itoa(dryRun.nextId())
+		return &File{FileId: fileId, FileUniqueId: fileId}
+	case "createForumTopic":
+		return &ForumTopic{MessageThreadId: dryRun.nextId(), Name: params.Name, IconColor: params.IconColor}
+	case "createInvoiceLink":
+		return "https://t.me/$dryrun" + strconv.Itoa(dryRun.nextId())
+	case "answerWebAppQuery":
+		return &SentWebAppMessage{}
+	}
+	return true
+}
+
+func (dryRun *DryRun) nextId() int {
+	dryRun.mutex.Lock()
+	defer dryRun.mutex.Unlock()
+	dryRun.lastId++
+	return dryRun.lastId
+}
+
+func (dryRun *DryRun) message(params *dryRunParams, messageId int) *Message {
+	chat := &Chat{Type: "private"}
+
+	if id, ok := params.ChatId.Id(); ok {
+		chat.Id = id
+
+		if id < 0 {
+			chat.Type = "supergroup"
+		}
+	} else {
+		chat.Type = "channel"
+		username, _ := params.ChatId.Username()
+		chat.Username = strings.TrimPrefix(username, "@")
+	}
+	replyMarkup := params.ReplyMarkup
+
+	// Other kinds of reply markup aren't attached to the message
+	if replyMarkup != nil && len(replyMarkup.InlineKeyboard) == 0 {
+		replyMarkup = nil
+	}
+	return &Message{
+		MessageId:       messageId,
+		MessageThreadId: params.MessageThreadId,
+		Date:            int(time.Now().Unix()),
+		Chat:            chat,
+		Text:            params.Text,
+		Entities:        params.Entities,
+		Caption:         params.Caption,
+		CaptionEntities: params.CaptionEntities,
+		ReplyMarkup:     replyMarkup,
+	}
+}
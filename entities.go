@@ -0,0 +1,104 @@
+package gobot
+
+import "unicode/utf16"
+
+// EntityText returns the part of text covered by the entity. Offset and length of entities are
+// expressed in UTF-16 code units, so slicing the Go string directly breaks on emoji and non-Latin text.
+func EntityText(text string, entity *MessageEntity) string {
+	units := utf16.Encode([]rune(text))
+	start, end := entity.Offset, entity.Offset+entity.Length
+
+	if start < 0 {
+		start = 0
+	}
+
+	if end > len(units) {
+		end = len(units)
+	}
+
+	if start >= end {
+		return ""
+	}
+	return string(utf16.Decode(units[start:end]))
+}
+
+// EntityText returns the part of the text, or of the caption for media messages, covered by the entity.
+func (message *Message) EntityText(entity *MessageEntity) string {
+	text, _ := message.TextWithEntities()
+	return EntityText(text, entity)
+}
+
+// TextWithEntities returns the text and its entities, or the caption and its entities for media messages.
+func (message *Message) TextWithEntities() (string, []*MessageEntity) {
+	if message.Text == "" && message.Caption != "" {
+		return message.Caption, message.CaptionEntities
+	}
+	return message.Text, message.Entities
+}
+
+// EntityTexts returns the text covered by each of the entities of the given types, in order of appearance.
+func (message *Message) EntityTexts(entityTypes ...string) []string {
+	text, entities := message.TextWithEntities()
+	units := utf16.Encode([]rune(text))
+	var texts []string
+
+	for _, entity := range entities {
+		for _, entityType := range entityTypes {
+			if entity.Type == entityType {
+				start, end := entity.Offset, entity.Offset+entity.Length
+
+				if start >= 0 && end <= len(units) && start < end {
+					texts = append(texts, string(utf16.Decode(units[start:end])))
+				}
+				break
+			}
+		}
+	}
+	return texts
+}
+
+// Mentions returns the @usernames mentioned in the message.
+func (message *Message) Mentions() []string {
+	return message.EntityTexts("mention")
+}
+
+// MentionedUsers returns the users mentioned in the message without a username.
+func (message *Message) MentionedUsers() []*User {
+	_, entities := message.TextWithEntities()
+	var users []*User
+
+	for _, entity := range entities {
+		if entity.Type == "text_mention" && entity.User != nil {
+			users = append(users, entity.User)
+		}
+	}
+	return users
+}
+
+func (message *Message) Hashtags() []string {
+	return message.EntityTexts("hashtag")
+}
+
+func (message *Message) Cashtags() []string {
+	return message.EntityTexts("cashtag")
+}
+
+// Urls returns both the URLs written in the message and the URLs of its text links.
+func (message *Message) Urls() []string {
+	text, entities := message.TextWithEntities()
+	var urls []string
+
+	for _, entity := range entities {
+		if entity.Type == "url" {
+			urls = append(urls, EntityText(text, entity))
+		} else if entity.Type == "text_link" {
+			urls = append(urls, entity.Url)
+		}
+	}
+	return urls
+}
+
+// Commands returns the bot commands in the message, e.g. "/start" or "/start@jobs_bot".
+func (message *Message) Commands() []string {
+	return message.EntityTexts("bot_command")
+}
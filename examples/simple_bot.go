@@ -5,24 +5,13 @@ import (
 	"github.com/mattiabrandon/gobot"
 )
 
+var menus *gobot.MenuSystem
+
 func messageHandler(bot *gobot.GoBot, update *gobot.Update) {
 	message := update.Message
 
 	if message.Text == "/start" {
-		keyboard := gobot.NewInlineKeyboardMarkup(
-			gobot.NewInlineKeyboardRow(
-				gobot.NewInlineKeyboardButton("I'm a button", "/callback", true),
-				gobot.NewInlineKeyboardButton("This is Google", "https://google.it", false),
-			),
-			gobot.NewInlineKeyboardRow(
-				gobot.NewInlineKeyboardButton("This is DuckDuckGo", "https://duckduckgo.com", false),
-			),
-		)
-
-		if _, err := bot.SendMessage(message.NewSendMessage(
-			"<b>Hello World</b>",
-			keyboard,
-		)); err != nil {
+		if _, err := menus.Send(bot, update); err != nil {
 			fmt.Println(err)
 		}
 	} else {
@@ -35,49 +24,17 @@ func messageHandler(bot *gobot.GoBot, update *gobot.Update) {
 	}
 }
 
-func callbackQueryHandler(bot *gobot.GoBot, update *gobot.Update) {
-	callbackQuery := update.CallbackQuery
-
-	if callbackQuery.Data == "/back" {
-		keyboard := gobot.NewInlineKeyboardMarkup(
-			gobot.NewInlineKeyboardRow(
-				gobot.NewInlineKeyboardButton("I'm a button", "/callback", true),
-				gobot.NewInlineKeyboardButton("This is Google", "https://google.it", false),
-			),
-			gobot.NewInlineKeyboardRow(
-				gobot.NewInlineKeyboardButton("This is DuckDuckGo", "https://duckduckgo.com", false),
-			),
-		)
-
-		if _, err := bot.EditMessageText(callbackQuery.Message.NewEditMessageText(
-			"<b>Hello World</b>",
-			keyboard,
-		)); err != nil {
-			fmt.Println(err)
-		}
-	} else if update.CallbackQuery.Data == "/callback" {
-		keyboard := gobot.NewInlineKeyboardMarkup(gobot.NewInlineKeyboardRow(gobot.NewInlineKeyboardButton(
-			"Go back",
-			"/back",
-			true,
-		)))
-
-		if _, err := bot.EditMessageText(callbackQuery.Message.NewEditMessageText(
-			"This is a cool callback",
-			keyboard,
-		)); err != nil {
-			fmt.Println(err)
-		}
-	}
-
-	if _, err := bot.AnswerCallbackQuery(callbackQuery.NewAnswerCallbackQuery("", false)); err != nil {
-		fmt.Println(err)
-	}
-}
-
 func main() {
 	bot := gobot.Init("TOKEN")
+	callbackMenu := gobot.NewMenu("callback", "This is a cool callback")
+	mainMenu := gobot.NewMenu("main", "<b>Hello World</b>")
+	mainMenu.Columns = 2
+	mainMenu.
+		Submenu("I'm a button", callbackMenu).
+		Url("This is Google", "https://google.it").
+		Row().
+		Url("This is DuckDuckGo", "https://duckduckgo.com")
+	menus = gobot.NewMenuSystem(bot, "menu", mainMenu)
 	bot.AddHandler(&gobot.Message{}, messageHandler)
-	bot.AddHandler(&gobot.CallbackQuery{}, callbackQueryHandler)
 	_ = bot.Loop(false)
 }
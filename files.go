@@ -0,0 +1,67 @@
+package gobot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/valyala/fasthttp"
+)
+
+// Downloader is implemented by the transports that download the files themselves, e.g. a fake server in
+// tests. The files are downloaded over HTTP from the Bot API server if the transport doesn't implement it.
+type Downloader interface {
+	Download(filePath string, w io.Writer) error
+}
+
+// FileUrl returns the url to download a file, given the FilePath returned by GetFile. The url contains the bot's token.
+func (bot GoBot) FileUrl(filePath string) string {
+	return bot.apiUrl + "/file/bot" + bot.token + "/" + filePath
+}
+
+// DownloadFile writes to w the content of the file with the given FilePath, returned by GetFile.
+func (bot GoBot) DownloadFile(filePath string, w io.Writer) (err error) {
+	_, span := bot.startSpan("gobot.DownloadFile")
+	defer func() { endRequestSpan(span, err) }()
+	span.SetAttribute("telegram.file_path", filePath)
+
+	if downloader, ok := bot.transport.(Downloader); ok {
+		return downloader.Download(filePath, w)
+	}
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	req.SetRequestURI(bot.FileUrl(filePath))
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	if err := bot.client.Do(req, resp); err != nil {
+		return err
+	}
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return &Error{
+			Description: fmt.Sprintf("can't download file %s", filePath),
+			ErrorCode:   resp.StatusCode(),
+		}
+	}
+	return resp.BodyWriteTo(w)
+}
+
+// GetFileContent gets the path of the file with GetFile and downloads its content.
+func (bot GoBot) GetFileContent(fileId string) ([]byte, error) {
+	file, err := bot.GetFile(GetFileParams{FileId: fileId})
+
+	if err != nil {
+		return nil, err
+	}
+	var content bytes.Buffer
+
+	if file.FileSize > 0 {
+		content.Grow(file.FileSize)
+	}
+
+	if err := bot.DownloadFile(file.FilePath, &content); err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
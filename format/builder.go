@@ -0,0 +1,163 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+// Builder composes a formatted message. The result can be emitted either as text for a parse mode,
+// with all the user-provided text escaped, or as plain text plus the list of its entities.
+type Builder struct {
+	text     strings.Builder
+	length   int
+	entities []*gobot.MessageEntity
+}
+
+func New() *Builder {
+	return &Builder{}
+}
+
+func (builder *Builder) Text(text string) *Builder {
+	builder.text.WriteString(text)
+	builder.length += utf16Length(text)
+	return builder
+}
+
+func (builder *Builder) Textf(format string, args ...interface{}) *Builder {
+	return builder.Text(fmt.Sprintf(format, args...))
+}
+
+func (builder *Builder) Line(text string) *Builder {
+	return builder.Text(text + "\n")
+}
+
+func (builder *Builder) Bold(text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "bold"}, text)
+}
+
+func (builder *Builder) Italic(text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "italic"}, text)
+}
+
+func (builder *Builder) Underline(text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "underline"}, text)
+}
+
+func (builder *Builder) Strikethrough(text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "strikethrough"}, text)
+}
+
+func (builder *Builder) Spoiler(text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "spoiler"}, text)
+}
+
+func (builder *Builder) Blockquote(text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "blockquote"}, text)
+}
+
+// ExpandableBlockquote adds a quote collapsed by default.
+func (builder *Builder) ExpandableBlockquote(text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "expandable_blockquote"}, text)
+}
+
+func (builder *Builder) Code(text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "code"}, text)
+}
+
+func (builder *Builder) Pre(language string, text string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "pre", Language: language}, text)
+}
+
+func (builder *Builder) Link(text string, url string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "text_link", Url: url}, text)
+}
+
+// CustomEmoji adds a custom emoji sticker, shown as the given emoji where custom emoji aren't available.
+func (builder *Builder) CustomEmoji(emoji string, customEmojiId string) *Builder {
+	return builder.entity(&gobot.MessageEntity{Type: "custom_emoji", CustomEmojiId: customEmojiId}, emoji)
+}
+
+// Mention adds the full name of the user, linked to their profile even if they don't have a username.
+func (builder *Builder) Mention(user *gobot.User) *Builder {
+	name := user.FirstName
+
+	if user.LastName != "" {
+		name += " " + user.LastName
+	}
+	return builder.entity(&gobot.MessageEntity{Type: "text_mention", User: user}, name)
+}
+
+// Styled applies an entity of the given type, e.g. "bold", to everything added by build, allowing nested formatting.
+func (builder *Builder) Styled(entityType string, build func(builder *Builder)) *Builder {
+	entity := &gobot.MessageEntity{Type: entityType, Offset: builder.length}
+	builder.entities = append(builder.entities, entity)
+	build(builder)
+	entity.Length = builder.length - entity.Offset
+	return builder
+}
+
+func (builder *Builder) entity(entity *gobot.MessageEntity, text string) *Builder {
+	entity.Offset = builder.length
+	entity.Length = utf16Length(text)
+
+	if entity.Length > 0 {
+		builder.entities = append(builder.entities, entity)
+	}
+	return builder.Text(text)
+}
+
+// Len returns the length of the plain text in UTF-16 code units, the unit Telegram uses for its limits.
+func (builder *Builder) Len() int {
+	return builder.length
+}
+
+// PlainText returns the text without any formatting.
+func (builder *Builder) PlainText() string {
+	return builder.text.String()
+}
+
+// Entities returns the plain text and its entities, to be used instead of a parse mode.
+func (builder *Builder) Entities() (string, []*gobot.MessageEntity) {
+	entities := make([]*gobot.MessageEntity, 0, len(builder.entities))
+
+	for _, entity := range builder.entities {
+		if entity.Length > 0 {
+			copied := *entity
+			entities = append(entities, &copied)
+		}
+	}
+	return builder.text.String(), entities
+}
+
+// Render returns the text formatted for the given parse mode.
+func (builder *Builder) Render(mode ParseMode) string {
+	text, entities := builder.Entities()
+	return Render(mode, text, entities)
+}
+
+func (builder *Builder) HTML() string {
+	return builder.Render(HTML)
+}
+
+func (builder *Builder) Markdown() string {
+	return builder.Render(Markdown)
+}
+
+func (builder *Builder) MarkdownV2() string {
+	return builder.Render(MarkdownV2)
+}
+
+func utf16Length(text string) int {
+	length := 0
+
+	for _, r := range text {
+		if r >= 0x10000 {
+			length += 2
+		} else {
+			length++
+		}
+	}
+	return length
+}
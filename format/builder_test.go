@@ -0,0 +1,75 @@
+package format
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+func TestBuilderEntities(t *testing.T) {
+	builder := New().
+		Text("😀 ").
+		Bold("bold").
+		Text(" ").
+		Styled("italic", func(builder *Builder) {
+			builder.Text("it ").Code("𝔁")
+		}).
+		Link("", "https://example.com")
+	text, entities := builder.Entities()
+
+	if text != "😀 bold it 𝔁" {
+		t.Errorf("unexpected text %q", text)
+	}
+
+	if builder.Len() != 13 {
+		t.Errorf("expected a length of 13 UTF-16 code units, got %d", builder.Len())
+	}
+	expected := []*gobot.MessageEntity{
+		{Type: "bold", Offset: 3, Length: 4},
+		{Type: "italic", Offset: 8, Length: 5},
+		{Type: "code", Offset: 11, Length: 2},
+	}
+
+	if !reflect.DeepEqual(entities, expected) {
+		t.Errorf("expected %+v, got %+v", expected, entities)
+	}
+
+	if html := builder.HTML(); html != "😀 <b>bold</b> <i>it <code>𝔁</code></i>" {
+		t.Errorf("unexpected HTML %q", html)
+	}
+}
+
+func TestBuilderEscapesText(t *testing.T) {
+	builder := New().Text("1 < 2. ").Bold("*yes*")
+
+	if rendered := builder.HTML(); rendered != "1 &lt; 2. <b>*yes*</b>" {
+		t.Errorf("unexpected HTML %q", rendered)
+	}
+
+	if rendered := builder.MarkdownV2(); rendered != "1 < 2\\. *\\*yes\\**" {
+		t.Errorf("unexpected MarkdownV2 %q", rendered)
+	}
+
+	if rendered := builder.Markdown(); rendered != "1 < 2. **\\**yes*\\***" {
+		t.Errorf("unexpected Markdown %q", rendered)
+	}
+}
+
+func TestBuilderBlocks(t *testing.T) {
+	builder := New().
+		Line("Quote:").
+		Blockquote("first\nsecond").
+		Text("\n").
+		ExpandableBlockquote("long").
+		Text("\n").
+		CustomEmoji("👍", "42")
+
+	if rendered := builder.HTML(); rendered != "Quote:\n<blockquote>first\nsecond</blockquote>\n<blockquote expandable>long</blockquote>\n<tg-emoji emoji-id=\"42\">👍</tg-emoji>" {
+		t.Errorf("unexpected HTML %q", rendered)
+	}
+
+	if rendered := builder.MarkdownV2(); rendered != "Quote:\n>first\n>second\n>long||\n![👍](tg://emoji?id=42)" {
+		t.Errorf("unexpected MarkdownV2 %q", rendered)
+	}
+}
@@ -0,0 +1,57 @@
+package format
+
+import "strings"
+
+type ParseMode string
+
+const (
+	HTML       ParseMode = "HTML"
+	Markdown   ParseMode = "Markdown"
+	MarkdownV2 ParseMode = "MarkdownV2"
+)
+
+var (
+	htmlEscaper           = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\"", "&quot;")
+	markdownEscaper       = strings.NewReplacer("_", "\\_", "*", "\\*", "`", "\\`", "[", "\\[")
+	markdownV2Escaper     = newBackslashEscaper("\\_*[]()~`>#+-=|{}.!")
+	markdownV2CodeEscaper = newBackslashEscaper("\\`")
+	markdownV2LinkEscaper = newBackslashEscaper("\\)")
+	markdownLinkEscaper   = strings.NewReplacer(")", "%29")
+)
+
+func newBackslashEscaper(characters string) *strings.Replacer {
+	var replacements []string
+
+	for _, character := range characters {
+		replacements = append(replacements, string(character), "\\"+string(character))
+	}
+	return strings.NewReplacer(replacements...)
+}
+
+// EscapeHTML escapes the characters that have a special meaning in the HTML parse mode.
+func EscapeHTML(text string) string {
+	return htmlEscaper.Replace(text)
+}
+
+// EscapeMarkdown escapes the characters that have a special meaning in the legacy Markdown parse mode.
+func EscapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// EscapeMarkdownV2 escapes the characters that have a special meaning in the MarkdownV2 parse mode.
+func EscapeMarkdownV2(text string) string {
+	return markdownV2Escaper.Replace(text)
+}
+
+// Escape escapes the text for the given parse mode, an empty parse mode leaves the text as is.
+func Escape(mode ParseMode, text string) string {
+	switch mode {
+	case HTML:
+		return EscapeHTML(text)
+	case Markdown:
+		return EscapeMarkdown(text)
+	case MarkdownV2:
+		return EscapeMarkdownV2(text)
+	}
+	return text
+}
@@ -0,0 +1,24 @@
+package format
+
+import "testing"
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		mode     ParseMode
+		text     string
+		expected string
+	}{
+		{HTML, `a<b>&"c"`, "a&lt;b&gt;&amp;&quot;c&quot;"},
+		{HTML, "😀 'x'", "😀 'x'"},
+		{Markdown, "a_b*c`d[e]f", "a\\_b\\*c\\`d\\[e]f"},
+		{MarkdownV2, "1.5 + (2) = [x]!", "1\\.5 \\+ \\(2\\) \\= \\[x\\]\\!"},
+		{MarkdownV2, `_*~|>#{}\`, `\_\*\~\|\>\#\{\}\\`},
+		{"", "a_b<c>", "a_b<c>"},
+	}
+
+	for _, test := range tests {
+		if escaped := Escape(test.mode, test.text); escaped != test.expected {
+			t.Errorf("%s %q: expected %q, got %q", test.mode, test.text, test.expected, escaped)
+		}
+	}
+}
@@ -0,0 +1,499 @@
+package format
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+// Render converts a text and its entities, whose offsets are in UTF-16 code units, into text formatted
+// for the given parse mode. Entities that can't be represented in the parse mode are rendered as plain text.
+func Render(mode ParseMode, text string, entities []*gobot.MessageEntity) string {
+	switch mode {
+	case HTML:
+		return render(htmlRenderer{}, text, entities)
+	case Markdown:
+		return render(&markdownRenderer{}, text, entities)
+	case MarkdownV2:
+		return render(&markdownV2Renderer{}, text, entities)
+	}
+	return text
+}
+
+func RenderHTML(text string, entities []*gobot.MessageEntity) string {
+	return Render(HTML, text, entities)
+}
+
+func RenderMarkdown(text string, entities []*gobot.MessageEntity) string {
+	return Render(Markdown, text, entities)
+}
+
+func RenderMarkdownV2(text string, entities []*gobot.MessageEntity) string {
+	return Render(MarkdownV2, text, entities)
+}
+
+type renderer interface {
+	supports(entity *gobot.MessageEntity) bool
+	nestable() bool
+	open(entity *gobot.MessageEntity) string
+	close(entity *gobot.MessageEntity) string
+	escape(text string, inside *gobot.MessageEntity) string
+	finish() string // Returns what is left to write at the end of the text
+}
+
+func render(r renderer, text string, entities []*gobot.MessageEntity) string {
+	units := utf16.Encode([]rune(text))
+	var sorted []*gobot.MessageEntity
+
+	for _, entity := range entities {
+		if entity == nil || entity.Length <= 0 || entity.Offset < 0 || entity.Offset >= len(units) || !r.supports(entity) {
+			continue
+		}
+		sorted = append(sorted, entity)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Offset != sorted[j].Offset {
+			return sorted[i].Offset < sorted[j].Offset
+		}
+		return sorted[i].Length > sorted[j].Length
+	})
+
+	if !r.nestable() {
+		sorted = removeOverlapping(sorted)
+	}
+	end := func(entity *gobot.MessageEntity) int {
+		if entity.Offset+entity.Length > len(units) {
+			return len(units)
+		}
+		return entity.Offset + entity.Length
+	}
+	boundaries := []int{0, len(units)}
+
+	for _, entity := range sorted {
+		boundaries = append(boundaries, entity.Offset, end(entity))
+	}
+	sort.Ints(boundaries)
+	unique := boundaries[:1]
+
+	for _, position := range boundaries[1:] {
+		if position != unique[len(unique)-1] {
+			unique = append(unique, position)
+		}
+	}
+	boundaries = unique
+	var result strings.Builder
+	var stack []*gobot.MessageEntity
+	next := 0
+
+	for i, position := range boundaries {
+		closing := -1
+
+		for j, entity := range stack {
+			if end(entity) <= position {
+				closing = j
+				break
+			}
+		}
+
+		if closing >= 0 {
+			var reopen []*gobot.MessageEntity
+
+			for j := len(stack) - 1; j >= closing; j-- {
+				result.WriteString(r.close(stack[j]))
+
+				if end(stack[j]) > position {
+					reopen = append([]*gobot.MessageEntity{stack[j]}, reopen...)
+				}
+			}
+			stack = stack[:closing]
+
+			for _, entity := range reopen {
+				result.WriteString(r.open(entity))
+				stack = append(stack, entity)
+			}
+		}
+
+		for next < len(sorted) && sorted[next].Offset == position {
+			result.WriteString(r.open(sorted[next]))
+			stack = append(stack, sorted[next])
+			next++
+		}
+
+		if i+1 < len(boundaries) {
+			var inside *gobot.MessageEntity
+
+			if len(stack) > 0 {
+				inside = stack[len(stack)-1]
+			}
+			result.WriteString(r.escape(string(utf16.Decode(units[position:boundaries[i+1]])), inside))
+		}
+	}
+
+	for j := len(stack) - 1; j >= 0; j-- {
+		result.WriteString(r.close(stack[j]))
+	}
+	result.WriteString(r.finish())
+	return result.String()
+}
+
+func removeOverlapping(entities []*gobot.MessageEntity) []*gobot.MessageEntity {
+	var result []*gobot.MessageEntity
+	end := 0
+
+	for _, entity := range entities {
+		if entity.Offset >= end {
+			result = append(result, entity)
+			end = entity.Offset + entity.Length
+		}
+	}
+	return result
+}
+
+func mentionUrl(user *gobot.User) string {
+	return "tg://user?id=" + strconv.Itoa(user.Id)
+}
+
+type htmlRenderer struct{}
+
+func (htmlRenderer) supports(entity *gobot.MessageEntity) bool {
+	switch entity.Type {
+	case "bold", "italic", "underline", "strikethrough", "spoiler", "blockquote", "expandable_blockquote", "code", "pre", "text_link":
+		return true
+	case "text_mention":
+		return entity.User != nil
+	case "custom_emoji":
+		return entity.CustomEmojiId != ""
+	}
+	return false
+}
+
+func (htmlRenderer) nestable() bool {
+	return true
+}
+
+func (htmlRenderer) open(entity *gobot.MessageEntity) string {
+	switch entity.Type {
+	case "bold":
+		return "<b>"
+	case "italic":
+		return "<i>"
+	case "underline":
+		return "<u>"
+	case "strikethrough":
+		return "<s>"
+	case "spoiler":
+		return "<tg-spoiler>"
+	case "blockquote":
+		return "<blockquote>"
+	case "expandable_blockquote":
+		return "<blockquote expandable>"
+	case "code":
+		return "<code>"
+	case "pre":
+		if entity.Language != "" {
+			return `<pre><code class="language-` + EscapeHTML(entity.Language) + `">`
+		}
+		return "<pre>"
+	case "text_link":
+		return `<a href="` + EscapeHTML(entity.Url) + `">`
+	case "text_mention":
+		return `<a href="` + mentionUrl(entity.User) + `">`
+	case "custom_emoji":
+		return `<tg-emoji emoji-id="` + EscapeHTML(entity.CustomEmojiId) + `">`
+	}
+	return ""
+}
+
+func (htmlRenderer) close(entity *gobot.MessageEntity) string {
+	switch entity.Type {
+	case "bold":
+		return "</b>"
+	case "italic":
+		return "</i>"
+	case "underline":
+		return "</u>"
+	case "strikethrough":
+		return "</s>"
+	case "spoiler":
+		return "</tg-spoiler>"
+	case "blockquote", "expandable_blockquote":
+		return "</blockquote>"
+	case "code":
+		return "</code>"
+	case "pre":
+		if entity.Language != "" {
+			return "</code></pre>"
+		}
+		return "</pre>"
+	case "text_link", "text_mention":
+		return "</a>"
+	case "custom_emoji":
+		return "</tg-emoji>"
+	}
+	return ""
+}
+
+func (htmlRenderer) escape(text string, _ *gobot.MessageEntity) string {
+	return EscapeHTML(text)
+}
+
+func (htmlRenderer) finish() string {
+	return ""
+}
+
+// markdownV2Renderer starts every line of a blockquote with ">". The end of a quote is written lazily, so
+// that a quote closed and reopened around an overlapping entity goes on, and that the "||" of an expandable
+// quote ending with a newline is written before it.
+type markdownV2Renderer struct {
+	last    string
+	quote   *gobot.MessageEntity // Blockquote being written
+	closed  *gobot.MessageEntity // Blockquote closed but whose end isn't written yet
+	newLine bool                 // Whether a newline of the quote is yet to be written
+	midLine bool                 // Whether the output doesn't end with a newline
+}
+
+func (*markdownV2Renderer) supports(entity *gobot.MessageEntity) bool {
+	return htmlRenderer{}.supports(entity)
+}
+
+func (*markdownV2Renderer) nestable() bool {
+	return true
+}
+
+// write returns the output preceded by what the quotes left to write: the end of a closed quote, or the
+// newline and ">" of a new line of the current one.
+func (r *markdownV2Renderer) write(output string) string {
+	var prefix string
+
+	if r.closed != nil {
+		if r.closed.Type == "expandable_blockquote" {
+			prefix = "||"
+		}
+
+		if r.newLine {
+			prefix += "\n"
+		}
+		r.closed = nil
+		r.newLine = false
+	} else if r.newLine && r.quote != nil {
+		prefix = "\n>"
+		r.newLine = false
+	}
+	output = prefix + output
+
+	if output != "" {
+		r.midLine = !strings.HasSuffix(output, "\n")
+	}
+	return output
+}
+
+// delimiter avoids the ambiguity between italic and underline delimiters, e.g. "_" followed by "__".
+func (r *markdownV2Renderer) delimiter(delimiter string) string {
+	if strings.HasSuffix(r.last, "_") && strings.HasPrefix(delimiter, "_") {
+		delimiter = "\r" + delimiter
+	}
+	r.last = delimiter
+	return r.write(delimiter)
+}
+
+func (r *markdownV2Renderer) open(entity *gobot.MessageEntity) string {
+	switch entity.Type {
+	case "bold":
+		return r.delimiter("*")
+	case "italic":
+		return r.delimiter("_")
+	case "underline":
+		return r.delimiter("__")
+	case "strikethrough":
+		return r.delimiter("~")
+	case "spoiler":
+		return r.delimiter("||")
+	case "blockquote", "expandable_blockquote":
+		if r.closed == entity {
+			r.quote = entity
+			r.closed = nil
+			return ""
+		}
+		output := r.write("")
+		r.quote = entity
+
+		if r.midLine {
+			// A quote can only start at the beginning of a line
+			return output
+		}
+		return output + r.delimiter(">")
+	case "code":
+		return r.delimiter("`")
+	case "pre":
+		return r.delimiter("```" + entity.Language + "\n")
+	case "text_link", "text_mention":
+		return r.delimiter("[")
+	case "custom_emoji":
+		return r.delimiter("![")
+	}
+	return ""
+}
+
+func (r *markdownV2Renderer) close(entity *gobot.MessageEntity) string {
+	if entity.Type == "blockquote" || entity.Type == "expandable_blockquote" {
+		r.quote = nil
+		r.closed = entity
+		return ""
+	}
+	// The end of a closed quote is written after the entities closed with it, as it may be reopened
+	closed := r.closed
+	r.closed = nil
+	defer func() { r.closed = closed }()
+	return r.closeDelimiter(entity)
+}
+
+func (r *markdownV2Renderer) closeDelimiter(entity *gobot.MessageEntity) string {
+	switch entity.Type {
+	case "pre":
+		return r.delimiter("```")
+	case "text_link":
+		return r.delimiter("](" + markdownV2LinkEscaper.Replace(entity.Url) + ")")
+	case "text_mention":
+		return r.delimiter("](" + mentionUrl(entity.User) + ")")
+	case "custom_emoji":
+		return r.delimiter("](tg://emoji?id=" + markdownV2LinkEscaper.Replace(entity.CustomEmojiId) + ")")
+	}
+	return r.open(entity)
+}
+
+// escape holds back a newline ending the text of a quote, as the quote may end there.
+func (r *markdownV2Renderer) escape(text string, inside *gobot.MessageEntity) string {
+	r.last = ""
+
+	if inside != nil && (inside.Type == "code" || inside.Type == "pre") {
+		text = markdownV2CodeEscaper.Replace(text)
+	} else {
+		text = EscapeMarkdownV2(text)
+	}
+
+	if r.quote == nil {
+		return r.write(text)
+	}
+	newLine := strings.HasSuffix(text, "\n")
+	output := r.write(strings.ReplaceAll(strings.TrimSuffix(text, "\n"), "\n", "\n>"))
+	r.newLine = newLine
+	return output
+}
+
+func (r *markdownV2Renderer) finish() string {
+	return r.write("")
+}
+
+// markdownRenderer opens the links lazily, so that a "]" in their text, which can't be escaped, is written
+// between two links with the same url instead of ending the text of the link.
+type markdownRenderer struct {
+	linkOpen bool // Whether the text of the current link was opened with "["
+}
+
+func (*markdownRenderer) supports(entity *gobot.MessageEntity) bool {
+	switch entity.Type {
+	case "bold", "italic", "code", "pre", "text_link":
+		return true
+	case "text_mention":
+		return entity.User != nil
+	}
+	return false
+}
+
+func (*markdownRenderer) nestable() bool {
+	return false
+}
+
+func (r *markdownRenderer) open(entity *gobot.MessageEntity) string {
+	switch entity.Type {
+	case "bold":
+		return "*"
+	case "italic":
+		return "_"
+	case "code":
+		return "`"
+	case "pre":
+		return "```" + entity.Language + "\n"
+	case "text_link", "text_mention":
+		r.linkOpen = false
+	}
+	return ""
+}
+
+func (r *markdownRenderer) close(entity *gobot.MessageEntity) string {
+	switch entity.Type {
+	case "pre":
+		return "```"
+	case "text_link", "text_mention":
+		return r.closeLink(entity)
+	}
+	return r.open(entity)
+}
+
+func (r *markdownRenderer) closeLink(entity *gobot.MessageEntity) string {
+	if !r.linkOpen {
+		return ""
+	}
+	r.linkOpen = false
+
+	if entity.Type == "text_mention" {
+		return "](" + mentionUrl(entity.User) + ")"
+	}
+	return "](" + markdownLinkEscaper.Replace(entity.Url) + ")"
+}
+
+// escape can't use backslashes inside entities, so the entity is closed around its own delimiter.
+func (r *markdownRenderer) escape(text string, inside *gobot.MessageEntity) string {
+	if inside == nil {
+		return EscapeMarkdown(text)
+	}
+
+	switch inside.Type {
+	case "bold":
+		return strings.ReplaceAll(text, "*", "*\\**")
+	case "italic":
+		return strings.ReplaceAll(text, "_", "_\\__")
+	case "code":
+		return strings.ReplaceAll(text, "`", "`\\``")
+	case "text_link", "text_mention":
+		var builder strings.Builder
+
+		for i, part := range strings.Split(text, "]") {
+			if i > 0 {
+				builder.WriteString(r.closeLink(inside) + "]")
+			}
+
+			if part != "" {
+				if !r.linkOpen {
+					builder.WriteString("[")
+					r.linkOpen = true
+				}
+				builder.WriteString(part)
+			}
+		}
+		return builder.String()
+	}
+	return text
+}
+
+func (*markdownRenderer) finish() string {
+	return ""
+}
+
+// RenderMessage formats the text and entities of the message, or its caption and caption entities, for the
+// given parse mode, so that it can be sent again, possibly after edits, without losing its formatting.
+func RenderMessage(mode ParseMode, message *gobot.Message) string {
+	text, entities := message.TextWithEntities()
+	return Render(mode, text, entities)
+}
+
+func MessageHTML(message *gobot.Message) string {
+	return RenderMessage(HTML, message)
+}
+
+func MessageMarkdownV2(message *gobot.Message) string {
+	return RenderMessage(MarkdownV2, message)
+}
@@ -0,0 +1,142 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+func entity(entityType string, offset int, length int) *gobot.MessageEntity {
+	return &gobot.MessageEntity{Type: entityType, Offset: offset, Length: length}
+}
+
+func TestRender(t *testing.T) {
+	link := &gobot.MessageEntity{Type: "text_link", Length: 4, Url: "http://a/(b)?c=1&d=2"}
+	mention := &gobot.MessageEntity{Type: "text_mention", Length: 3, User: &gobot.User{Id: 42}}
+	tests := []struct {
+		name       string
+		text       string
+		entities   []*gobot.MessageEntity
+		html       string
+		markdownV2 string
+		markdown   string
+	}{
+		{
+			"plain", "a<b>.", nil,
+			"a&lt;b&gt;.", "a<b\\>\\.", "a<b>.",
+		},
+		{
+			"surrogate pair before", "😀 bold", []*gobot.MessageEntity{entity("bold", 3, 4)},
+			"😀 <b>bold</b>", "😀 *bold*", "😀 *bold*",
+		},
+		{
+			"surrogate pair inside", "a😀b", []*gobot.MessageEntity{entity("italic", 1, 2)},
+			"a<i>😀</i>b", "a_😀_b", "a_😀_b",
+		},
+		{
+			"nested", "bold italic", []*gobot.MessageEntity{entity("bold", 0, 11), entity("italic", 5, 6)},
+			"<b>bold <i>italic</i></b>", "*bold _italic_*", "*bold italic*",
+		},
+		{
+			"overlapping", "abcdef", []*gobot.MessageEntity{entity("bold", 0, 4), entity("italic", 2, 4)},
+			"<b>ab<i>cd</i></b><i>ef</i>", "*ab_cd_*_ef_", "*abcd*ef",
+		},
+		{
+			"same offset", "abcdef", []*gobot.MessageEntity{entity("italic", 0, 3), entity("bold", 0, 6)},
+			"<b><i>abc</i>def</b>", "*_abc_def*", "*abcdef*",
+		},
+		{
+			"italic and underline", "abc", []*gobot.MessageEntity{entity("italic", 0, 3), entity("underline", 0, 3)},
+			"<i><u>abc</u></i>", "_\r__abc__\r_", "_abc_",
+		},
+		{
+			"code", "a`b\\c", []*gobot.MessageEntity{entity("code", 0, 5)},
+			"<code>a`b\\c</code>", "`a\\`b\\\\c`", "`a`\\``b\\c`",
+		},
+		{
+			"pre", "x<1", []*gobot.MessageEntity{{Type: "pre", Length: 3, Language: "go"}},
+			"<pre><code class=\"language-go\">x&lt;1</code></pre>", "```go\nx<1```", "```go\nx<1```",
+		},
+		{
+			"delimiter inside", "a*b_c", []*gobot.MessageEntity{entity("bold", 0, 5)},
+			"<b>a*b_c</b>", "*a\\*b\\_c*", "*a*\\**b_c*",
+		},
+		{
+			"link", "x]y]", []*gobot.MessageEntity{link},
+			"<a href=\"http://a/(b)?c=1&amp;d=2\">x]y]</a>",
+			"[x\\]y\\]](http://a/(b\\)?c=1&d=2)",
+			"[x](http://a/(b%29?c=1&d=2)][y](http://a/(b%29?c=1&d=2)]",
+		},
+		{
+			"link starting with a bracket", "]ab", []*gobot.MessageEntity{{Type: "text_link", Length: 3, Url: "u"}},
+			"<a href=\"u\">]ab</a>", "[\\]ab](u)", "][ab](u)",
+		},
+		{
+			"mention", "Bob", []*gobot.MessageEntity{mention},
+			"<a href=\"tg://user?id=42\">Bob</a>", "[Bob](tg://user?id=42)", "[Bob](tg://user?id=42)",
+		},
+		{
+			"blockquote", "one\ntwo\nafter", []*gobot.MessageEntity{entity("blockquote", 0, 8)},
+			"<blockquote>one\ntwo\n</blockquote>after", ">one\n>two\nafter", "one\ntwo\nafter",
+		},
+		{
+			"expandable blockquote", "one\ntwo\nafter", []*gobot.MessageEntity{entity("expandable_blockquote", 0, 8)},
+			"<blockquote expandable>one\ntwo\n</blockquote>after", ">one\n>two||\nafter", "one\ntwo\nafter",
+		},
+		{
+			"expandable blockquote at the end", "a.\n\nb", []*gobot.MessageEntity{entity("expandable_blockquote", 0, 5)},
+			"<blockquote expandable>a.\n\nb</blockquote>", ">a\\.\n>\n>b||", "a.\n\nb",
+		},
+		{
+			"blockquote after a line", "x\nab", []*gobot.MessageEntity{entity("blockquote", 2, 2)},
+			"x\n<blockquote>ab</blockquote>", "x\n>ab", "x\nab",
+		},
+		{
+			"entity across the lines of a blockquote", "ab\ncd", []*gobot.MessageEntity{entity("blockquote", 0, 5), entity("bold", 1, 3)},
+			"<blockquote>a<b>b\nc</b>d</blockquote>", ">a*b\n>c*d", "a*b\nc*d",
+		},
+		{
+			"entity overlapping the end of a blockquote", "ab\ncde", []*gobot.MessageEntity{entity("expandable_blockquote", 0, 5), entity("bold", 1, 5)},
+			"<blockquote expandable>a<b>b\ncd</b></blockquote><b>e</b>", ">a*b\n>cd*||*e*", "a*b\ncde*",
+		},
+		{
+			"entity overlapping the start of a blockquote", "ab\ncd\ne", []*gobot.MessageEntity{entity("bold", 0, 4), entity("expandable_blockquote", 3, 3)},
+			"<b>ab\n<blockquote expandable>c</blockquote></b><blockquote expandable>d\n</blockquote>e", "*ab\n>c*d||\ne", "*ab\nc*d\ne",
+		},
+		{
+			"custom emoji", "👍 ok", []*gobot.MessageEntity{{Type: "custom_emoji", Length: 2, CustomEmojiId: "5368324170671202286"}},
+			"<tg-emoji emoji-id=\"5368324170671202286\">👍</tg-emoji> ok", "![👍](tg://emoji?id=5368324170671202286) ok", "👍 ok",
+		},
+		{
+			"custom emoji without id", "👍", []*gobot.MessageEntity{entity("custom_emoji", 0, 2)},
+			"👍", "👍", "👍",
+		},
+		{
+			"unsupported and invalid", "#tag end",
+			[]*gobot.MessageEntity{entity("hashtag", 0, 4), entity("bold", 9, 2), entity("bold", 1, 0), nil, entity("underline", 5, 10)},
+			"#tag <u>end</u>", "\\#tag __end__", "#tag end",
+		},
+	}
+
+	for _, test := range tests {
+		for _, mode := range []struct {
+			mode     ParseMode
+			expected string
+		}{{HTML, test.html}, {MarkdownV2, test.markdownV2}, {Markdown, test.markdown}} {
+			if rendered := Render(mode.mode, test.text, test.entities); rendered != mode.expected {
+				t.Errorf("%s, %s: expected %q, got %q", test.name, mode.mode, mode.expected, rendered)
+			}
+		}
+	}
+}
+
+func TestRenderMessage(t *testing.T) {
+	message := &gobot.Message{
+		Caption:         "😀 hi",
+		CaptionEntities: []*gobot.MessageEntity{entity("bold", 3, 2)},
+	}
+
+	if rendered := MessageHTML(message); rendered != "😀 <b>hi</b>" {
+		t.Errorf("expected the caption to be rendered, got %q", rendered)
+	}
+}
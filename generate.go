@@ -0,0 +1,3 @@
+package gobot
+
+//go:generate go run ./cmd/gobot-gen
@@ -1,21 +1,53 @@
 package gobot
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"log"
 	"reflect"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/valyala/fasthttp"
 )
 
 type GoBot struct {
-	client   *fasthttp.Client
-	Timeout  int
-	baseURL  string
-	handlers []Handler
+	*botState
+	Timeout       int
+	CallbackCodec *CallbackCodec
+	Validate      bool // Checks the params with ValidateParams before sending them
+	MaxRetries    int  // Number of times a request failed because of flood control is retried, after waiting as asked
+	ctx           context.Context
+	update        *Update // Update being handled by the copy passed to the handlers, for the logs
 }
 
+// botState is the state of a bot shared with its copies made by WithContext, so that the setup made
+// through a copy, e.g. by a handler adding a callback, applies to the bot.
+type botState struct {
+	queueDepth  int64 // First so that it's aligned for the atomic operations
+	client      *fasthttp.Client
+	token       string
+	apiUrl      string
+	baseURL     string
+	mutex       sync.RWMutex // Guards the handlers, the middlewares and the media groups, that can be added while handling updates
+	handlers    []Handler
+	callbacks   *callbackRouter
+	mediaGroups *mediaGroupAggregator
+	middlewares []Middleware
+	transport   Transport
+	metrics     Metrics
+	tracer      Tracer
+	clock       Clock
+	manager     *Manager
+	name        string
+	logger      Logger
+	stop        chan struct{}
+	stopOnce    sync.Once
+}
+
+const defaultApiUrl = "https://api.telegram.org"
+
 type Handler struct {
 	updateType reflect.Type
 	callback   func(bot *GoBot, update *Update)
@@ -24,28 +56,112 @@ type Handler struct {
 var updateTypeUpdate = reflect.TypeOf(&Update{})
 
 func Init(token string) *GoBot {
-	log.Println("Copyright (c) 2020 Mattia Brandon <https://github.com/mattiabrandon>")
 	bot := GoBot{
-		client:   &fasthttp.Client{},
-		Timeout:  12,
-		baseURL:  "https://api.telegram.org/bot" + token + "/",
-		handlers: []Handler{},
+		botState:      newBotState(token),
+		Timeout:       12,
+		CallbackCodec: NewCallbackCodec(nil),
 	}
 	return &bot
 }
 
 func InitTimeout(token string, timeout int) *GoBot {
-	log.Println("Copyright (c) 2020 Mattia Brandon <https://github.com/mattiabrandon>")
 	bot := GoBot{
-		client:   &fasthttp.Client{},
-		Timeout:  timeout,
-		baseURL:  "https://api.telegram.org/bot" + token + "/",
-		handlers: []Handler{},
+		botState:      newBotState(token),
+		Timeout:       timeout,
+		CallbackCodec: NewCallbackCodec(nil),
 	}
 	return &bot
 }
 
-func (bot *GoBot) Request(method string, params interface{}) (json.RawMessage, error) {
+func newBotState(token string) *botState {
+	callbacks := &callbackRouter{routes: map[string]callbackRoute{}}
+	return &botState{
+		client:    &fasthttp.Client{},
+		token:     token,
+		apiUrl:    defaultApiUrl,
+		baseURL:   defaultApiUrl + "/bot" + token + "/",
+		handlers:  []Handler{newHandler(&CallbackQuery{}, callbacks.handle)},
+		callbacks: callbacks,
+		clock:     systemClock{},
+		logger:    newRedactingLogger(NewStdLogger(log.Default(), false), token),
+		stop:      make(chan struct{}),
+	}
+}
+
+// SetApiUrl makes the bot send its requests to another Bot API server, e.g. a local one or a fake one in tests.
+func (bot *GoBot) SetApiUrl(apiUrl string) {
+	bot.apiUrl = strings.TrimSuffix(apiUrl, "/")
+	bot.baseURL = bot.apiUrl + "/bot" + bot.token + "/"
+}
+
+func (bot *GoBot) Request(method string, params interface{}) (result json.RawMessage, err error) {
+	_, span := bot.startSpan("gobot.Request")
+	defer func() { endRequestSpan(span, err) }()
+	span.SetAttribute("telegram.method", method)
+	fields := []interface{}{"method", method}
+
+	if chatId, ok := chatIdOf(params); ok {
+		span.SetAttribute("telegram.chat_id", chatId)
+		fields = append(fields, "chat_id", chatId)
+	}
+
+	if bot.update != nil {
+		fields = append(fields, "update_id", bot.update.UpdateId)
+	}
+	return bot.request(method, params, fields)
+}
+
+// request validates the params and makes the request, retrying it after flood control.
+func (bot *GoBot) request(method string, params interface{}, fields []interface{}) (json.RawMessage, error) {
+	if bot.Validate {
+		if err := ValidateParams(method, params); err != nil {
+			if bot.metrics != nil {
+				bot.metrics.ObserveRequest(bot.name, method, 0, err)
+			}
+			bot.logger.Debug("Request not sent", append(fields, "error", err)...)
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		result, err := bot.do(method, params)
+		duration := time.Since(start)
+
+		if bot.metrics != nil {
+			bot.metrics.ObserveRequest(bot.name, method, duration, err)
+		}
+		attemptFields := append([]interface{}{"duration", duration}, fields...)
+
+		if err == nil {
+			bot.logger.Debug("Request sent", attemptFields...)
+			return result, nil
+		}
+		bot.logger.Debug("Request failed", append(attemptFields, "error", err)...)
+		wait, ok := retryAfter(err)
+
+		if !ok || attempt >= bot.MaxRetries {
+			return result, err
+		}
+
+		if bot.metrics != nil {
+			bot.metrics.ObserveRetry(bot.name, method)
+		}
+		bot.logger.Warn("Retrying after flood control", append([]interface{}{"retry_after", wait}, fields...)...)
+		time.Sleep(wait)
+	}
+}
+
+// do makes the request through the transport of the bot, or over HTTP if it has none.
+func (bot *GoBot) do(method string, params interface{}) (json.RawMessage, error) {
+	if bot.transport != nil {
+		return bot.transport.Do(method, params)
+	}
+	return bot.send(method, params)
+}
+
+// send makes the request to the Bot API server over HTTP.
+func (bot *GoBot) send(method string, params interface{}) (json.RawMessage, error) {
 	req := fasthttp.AcquireRequest()
 	defer fasthttp.ReleaseRequest(req)
 	req.SetRequestURI(bot.baseURL + method)
@@ -80,15 +196,78 @@ func (bot *GoBot) Request(method string, params interface{}) (json.RawMessage, e
 	return decodedBody.Result, nil
 }
 
+// AddHandler registers a callback for the updates of the given type, e.g. &Message{}, or for all of them
+// with &Update{}. It can be called while the bot is handling updates, e.g. from a handler.
 func (bot *GoBot) AddHandler(updateType interface{}, callback func(bot *GoBot, update *Update)) {
+	handler := newHandler(updateType, callback)
+	bot.mutex.Lock()
+	defer bot.mutex.Unlock()
+	bot.handlers = append(bot.handlers, handler)
+}
+
+func newHandler(updateType interface{}, callback func(bot *GoBot, update *Update)) Handler {
 	if reflect.ValueOf(updateType).Type().Kind() != reflect.Ptr {
 		panic("Update type must be a pointer")
 	}
-	bot.handlers = append(bot.handlers, Handler{reflect.TypeOf(updateType), callback})
+	return Handler{reflect.TypeOf(updateType), callback}
 }
 
-func (bot *GoBot) handleUpdate(update *Update) {
-	for _, handler := range bot.handlers {
+// HandleUpdate dispatches the update to the handlers, through the middlewares, e.g. for updates received
+// through a webhook. A panic of the handlers is recovered and logged.
+func (bot *GoBot) HandleUpdate(update *Update) {
+	bot.addQueueDepth(1)
+	defer bot.addQueueDepth(-1)
+	ctx, span := bot.startSpan("gobot.HandleUpdate")
+	defer span.End()
+	span.SetAttribute("telegram.update_id", update.UpdateId)
+	span.SetAttribute("telegram.update_type", UpdateType(update))
+	bot.mutex.RLock()
+	middlewares := bot.middlewares
+	bot.mutex.RUnlock()
+
+	if bot.manager != nil {
+		span.SetAttribute("telegram.bot", bot.name)
+		sharedMiddlewares, _ := bot.manager.shared(bot)
+		middlewares = append(sharedMiddlewares, middlewares...)
+	}
+	// The handlers get a copy of the bot carrying the context of the span, so that their requests are its children
+	updateBot := bot.WithContext(ctx)
+	updateBot.update = update
+	buffered := false
+	defer updateBot.recoverUpdate(update, time.Now(), span, &buffered)
+	var handler UpdateHandler = func(_ *GoBot, update *Update) {
+		buffered = updateBot.dispatch(update)
+	}
+
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	handler(updateBot, update)
+}
+
+// dispatch calls the handlers with the update, reporting whether it was buffered in a media group instead.
+func (bot *GoBot) dispatch(update *Update) bool {
+	// The slices are only appended to, so the handlers up to their length can be read without the lock
+	bot.mutex.RLock()
+	mediaGroups := bot.mediaGroups
+	handlers := bot.handlers
+	bot.mutex.RUnlock()
+
+	if mediaGroups != nil && mediaGroups.add(bot, update) {
+		return true
+	}
+	dispatchHandlers(handlers, bot, update)
+
+	if bot.manager != nil {
+		_, sharedHandlers := bot.manager.shared(bot)
+		dispatchHandlers(sharedHandlers, bot, update)
+	}
+	return false
+}
+
+// dispatchHandlers calls the handlers of the type of the update.
+func dispatchHandlers(handlers []Handler, bot *GoBot, update *Update) {
+	for _, handler := range handlers {
 		if handler.updateType == updateTypeUpdate {
 			handler.callback(bot, update)
 			continue
@@ -105,10 +284,15 @@ func (bot *GoBot) handleUpdate(update *Update) {
 }
 
 func (bot *GoBot) Loop(returnError bool) error {
-	log.Println("Starting the loop...")
+	bot.logger.Info("Starting the loop")
 	offset := 0
 
 	for {
+		select {
+		case <-bot.stop:
+			return nil
+		default:
+		}
 		updates, err := bot.GetUpdates(GetUpdatesParams{
 			Offset:  offset,
 			Timeout: bot.Timeout,
@@ -118,12 +302,19 @@ func (bot *GoBot) Loop(returnError bool) error {
 			if returnError {
 				return err
 			}
-			fmt.Println(err)
+			bot.logger.Error("Getting updates failed", "error", err)
 		}
 
 		for _, update := range updates {
 			offset = update.UpdateId + 1
-			go bot.handleUpdate(update)
+			go bot.HandleUpdate(update)
 		}
 	}
 }
+
+// Stop makes Loop return once the current request for updates completes.
+func (bot *GoBot) Stop() {
+	bot.stopOnce.Do(func() {
+		close(bot.stop)
+	})
+}
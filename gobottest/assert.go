@@ -0,0 +1,181 @@
+package gobottest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+// DefaultWait is how long the Expect functions wait for the bot to make the expected requests.
+var DefaultWait = 2 * time.Second
+
+// Requests returns the requests made with the method, or all of them if method is empty.
+func (server *Server) Requests(method string) []*Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	var requests []*Request
+
+	for _, request := range server.requests {
+		if method == "" || request.Method == method {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// Reset forgets the recorded requests, keeping chats and messages.
+func (server *Server) Reset() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.requests = nil
+}
+
+// SentMessages returns the messages the bot sent to the chat, in their current state.
+func (server *Server) SentMessages(chatId int) []*gobot.Message {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	var messages []*gobot.Message
+
+	for _, message := range server.sent[chatId] {
+		copied := *message
+		messages = append(messages, &copied)
+	}
+	return messages
+}
+
+// Message returns the current state of a message, or nil if it doesn't exist or was deleted.
+func (server *Server) Message(chatId int, messageId int) *gobot.Message {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	message := server.findMessage(&gobot.Chat{Id: chatId}, messageId)
+
+	if message == nil {
+		return nil
+	}
+	copied := *message
+	return &copied
+}
+
+// CallbackAnswer returns how the bot answered the callback query.
+func (server *Server) CallbackAnswer(callbackQueryId string) (*gobot.AnswerCallbackQueryParams, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	answer, ok := server.answers[callbackQueryId]
+	return answer, ok
+}
+
+// waitFor waits until the condition, evaluated with the server locked at every request, is true.
+func (server *Server) waitFor(timeout time.Duration, condition func() bool) bool {
+	timer := time.AfterFunc(timeout, func() {
+		server.mutex.Lock()
+		server.changed.Broadcast()
+		server.mutex.Unlock()
+	})
+	defer timer.Stop()
+	deadline := time.Now().Add(timeout)
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	for !condition() {
+		if server.closed || !time.Now().Before(deadline) {
+			return false
+		}
+		server.changed.Wait()
+	}
+	return true
+}
+
+// WaitForRequests waits until the bot made count requests with the method, returning them, or nil on timeout.
+func (server *Server) WaitForRequests(method string, count int, timeout time.Duration) []*Request {
+	var requests []*Request
+
+	if !server.waitFor(timeout, func() bool {
+		requests = requests[:0]
+
+		for _, request := range server.requests {
+			if request.Method == method {
+				requests = append(requests, request)
+			}
+		}
+		return len(requests) >= count
+	}) {
+		return nil
+	}
+	return requests
+}
+
+// ExpectRequest waits for a request with the method, failing the test if the bot doesn't make it in time.
+func (server *Server) ExpectRequest(t testing.TB, method string) *Request {
+	t.Helper()
+	count := len(server.Requests(method))
+	requests := server.WaitForRequests(method, count+1, DefaultWait)
+
+	if requests == nil {
+		t.Fatalf("expected a %s request", method)
+	}
+	return requests[len(requests)-1]
+}
+
+// ExpectMessage waits for the bot to send a message to the chat whose text or caption contains the given
+// text, failing the test if it isn't sent in time.
+func (server *Server) ExpectMessage(t testing.TB, chatId int, text string) *gobot.Message {
+	t.Helper()
+	var found *gobot.Message
+
+	if !server.waitFor(DefaultWait, func() bool {
+		for _, message := range server.sent[chatId] {
+			if strings.Contains(message.Text, text) || strings.Contains(message.Caption, text) {
+				copied := *message
+				found = &copied
+				return true
+			}
+		}
+		return false
+	}) {
+		t.Fatalf("expected a message containing %q in chat %d", text, chatId)
+	}
+	return found
+}
+
+// ExpectCallbackAnswer waits for the bot to answer the callback query, failing the test if it isn't answered in time.
+func (server *Server) ExpectCallbackAnswer(t testing.TB, callbackQueryId string) *gobot.AnswerCallbackQueryParams {
+	t.Helper()
+	var answer *gobot.AnswerCallbackQueryParams
+
+	if !server.waitFor(DefaultWait, func() bool {
+		answer = server.answers[callbackQueryId]
+		return answer != nil
+	}) {
+		t.Fatalf("expected an answer to callback query %s", callbackQueryId)
+	}
+	return answer
+}
+
+// FailNext makes the next request with the method fail with the error.
+func (server *Server) FailNext(method string, err *gobot.Error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.errors[method] = append(server.errors[method], err)
+}
+
+// TooManyRequests makes the next request with the method fail because of flood control.
+func (server *Server) TooManyRequests(method string, retryAfter int) {
+	server.FailNext(method, &gobot.Error{
+		ErrorCode:   http.StatusTooManyRequests,
+		Description: "Too Many Requests: retry after " + strconv.Itoa(retryAfter),
+		Parameters:  &gobot.ResponseParameters{RetryAfter: retryAfter},
+	})
+}
+
+func (server *Server) BadRequest(method string, description string) {
+	server.FailNext(method, newError(http.StatusBadRequest, "Bad Request: "+description))
+}
+
+// Forbidden makes the next request with the method fail, e.g. with "bot was blocked by the user".
+func (server *Server) Forbidden(method string, description string) {
+	server.FailNext(method, newError(http.StatusForbidden, "Forbidden: "+description))
+}
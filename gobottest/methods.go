@@ -0,0 +1,317 @@
+package gobottest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+// mediaFields maps the methods that send media to the field of the message holding it.
+var mediaFields = map[string]string{
+	"sendPhoto":     "photo",
+	"sendAudio":     "audio",
+	"sendDocument":  "document",
+	"sendVideo":     "video",
+	"sendAnimation": "animation",
+	"sendVoice":     "voice",
+	"sendVideoNote": "video_note",
+	"sendSticker":   "sticker",
+}
+
+// handle executes a method, with the server locked. Methods that aren't simulated succeed returning true.
+func (server *Server) handle(request *Request) (interface{}, *gobot.Error) {
+	switch request.Method {
+	case "getMe":
+		return server.Me, nil
+	case "sendMessage":
+		var text string
+		request.Param("text", &text)
+
+		if text == "" {
+			return nil, newError(http.StatusBadRequest, "Bad Request: message text is empty")
+		}
+		return server.sendMessage(request, map[string]interface{}{"text": text})
+	case "sendPhoto", "sendAudio", "sendDocument", "sendVideo", "sendAnimation", "sendVoice", "sendVideoNote", "sendSticker":
+		field := mediaFields[request.Method]
+		var fileId string
+		request.Param(field, &fileId)
+		file := map[string]interface{}{"file_id": fileId, "file_unique_id": fileId}
+		fields := map[string]interface{}{field: file}
+
+		if field == "photo" {
+			fields[field] = []interface{}{file}
+		}
+		var caption string
+
+		if request.Param("caption", &caption) {
+			fields["caption"] = caption
+		}
+		return server.sendMessage(request, fields)
+	case "sendLocation":
+		return server.sendMessage(request, map[string]interface{}{"location": params(request, "latitude", "longitude")})
+	case "sendVenue":
+		return server.sendMessage(request, map[string]interface{}{
+			"venue": map[string]interface{}{
+				"location": params(request, "latitude", "longitude"),
+				"title":    request.Params["title"],
+				"address":  request.Params["address"],
+			},
+		})
+	case "sendContact":
+		return server.sendMessage(request, map[string]interface{}{"contact": params(request, "phone_number", "first_name", "last_name")})
+	case "sendDice":
+		emoji := json.RawMessage(`"🎲"`)
+
+		if raw, ok := request.Params["emoji"]; ok {
+			emoji = raw
+		}
+		return server.sendMessage(request, map[string]interface{}{"dice": map[string]interface{}{"emoji": emoji, "value": 1}})
+	case "sendPoll":
+		return server.sendMessage(request, map[string]interface{}{"poll": params(request, "question", "type")})
+	case "sendInvoice":
+		return server.sendMessage(request, map[string]interface{}{"invoice": params(request, "title", "description", "currency")})
+	case "sendMediaGroup":
+		var media []map[string]json.RawMessage
+		request.Param("media", &media)
+		var messages []*gobot.Message
+		server.nextMediaGroup++
+		mediaGroupId := strconv.Itoa(server.nextMediaGroup)
+
+		for _, item := range media {
+			var mediaType, fileId string
+			_ = json.Unmarshal(item["type"], &mediaType)
+			_ = json.Unmarshal(item["media"], &fileId)
+			file := map[string]interface{}{"file_id": fileId, "file_unique_id": fileId}
+			fields := map[string]interface{}{mediaType: file, "media_group_id": mediaGroupId}
+
+			if mediaType == "photo" {
+				fields[mediaType] = []interface{}{file}
+			}
+
+			if caption, ok := item["caption"]; ok {
+				fields["caption"] = caption
+			}
+			message, err := server.sendMessage(request, fields)
+
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, message)
+		}
+		return messages, nil
+	case "forwardMessage", "copyMessage":
+		var fromChatId *gobot.ChatId
+		var messageId int
+		request.Param("from_chat_id", &fromChatId)
+		request.Param("message_id", &messageId)
+		original := server.findMessage(server.chat(fromChatId), messageId)
+
+		if original == nil {
+			return nil, newError(http.StatusBadRequest, "Bad Request: message to copy not found")
+		}
+		fields := map[string]interface{}{}
+		encoded, _ := json.Marshal(original)
+		_ = json.Unmarshal(encoded, &fields)
+
+		for _, key := range []string{"message_id", "chat", "from", "date", "reply_markup", "reply_to_message", "forward_origin"} {
+			delete(fields, key)
+		}
+
+		if request.Method == "forwardMessage" {
+			fields["forward_origin"] = &gobot.MessageOriginUser{Date: original.Date, SenderUser: original.From}
+		}
+		message, err := server.sendMessage(request, fields)
+
+		if err != nil || request.Method == "forwardMessage" {
+			return message, err
+		}
+		return &gobot.MessageId{MessageId: message.MessageId}, nil
+	case "editMessageText", "editMessageCaption", "editMessageReplyMarkup", "editMessageMedia":
+		return server.editMessage(request)
+	case "deleteMessage":
+		var chatId *gobot.ChatId
+		var messageId int
+		request.Param("chat_id", &chatId)
+		request.Param("message_id", &messageId)
+		chat := server.chat(chatId)
+
+		if server.findMessage(chat, messageId) == nil {
+			return nil, newError(http.StatusBadRequest, "Bad Request: message to delete not found")
+		}
+		delete(server.messages[chat.Id], messageId)
+		return true, nil
+	case "answerCallbackQuery":
+		var params gobot.AnswerCallbackQueryParams
+
+		if err := request.Decode(&params); err != nil {
+			return nil, newError(http.StatusBadRequest, "Bad Request: "+err.Error())
+		}
+
+		if _, ok := server.answers[params.CallbackQueryId]; ok {
+			return nil, newError(http.StatusBadRequest, "Bad Request: query is too old and response timeout expired or query ID is invalid")
+		}
+		server.answers[params.CallbackQueryId] = &params
+		return true, nil
+	case "getChat":
+		var chatId *gobot.ChatId
+		request.Param("chat_id", &chatId)
+		return server.chat(chatId), nil
+	case "getFile":
+		var fileId string
+		request.Param("file_id", &fileId)
+		stored, ok := server.files[fileId]
+
+		if !ok {
+			return nil, newError(http.StatusBadRequest, "Bad Request: invalid file_id")
+		}
+		return stored.file, nil
+	case "getMyCommands":
+		return []*gobot.BotCommand{}, nil
+	case "getWebhookInfo":
+		return &gobot.WebhookInfo{}, nil
+	}
+	return true, nil
+}
+
+// params copies the given params of the request, if present.
+func params(request *Request, names ...string) map[string]json.RawMessage {
+	copied := map[string]json.RawMessage{}
+
+	for _, name := range names {
+		if raw, ok := request.Params[name]; ok {
+			copied[name] = raw
+		}
+	}
+	return copied
+}
+
+// chat returns the chat with the given id or username, creating it if needed.
+func (server *Server) chat(chatId *gobot.ChatId) *gobot.Chat {
+	if id, ok := chatId.Id(); ok {
+		chat, ok := server.chats[id]
+
+		if !ok {
+			chat = &gobot.Chat{Id: id, Type: "private"}
+
+			if id < 0 {
+				chat.Type = "supergroup"
+			}
+			server.chats[id] = chat
+		}
+		return chat
+	}
+	username, _ := chatId.Username()
+
+	for _, chat := range server.chats {
+		if chat.Username == username {
+			return chat
+		}
+	}
+	server.nextId++
+	chat := &gobot.Chat{Id: -server.nextId, Type: "channel", Username: username}
+	server.chats[chat.Id] = chat
+	return chat
+}
+
+func (server *Server) findMessage(chat *gobot.Chat, messageId int) *gobot.Message {
+	if messages, ok := server.messages[chat.Id]; ok {
+		return messages[messageId]
+	}
+	return nil
+}
+
+// store assigns the next message id of the chat to the message and stores it.
+func (server *Server) store(message *gobot.Message) {
+	server.lastId[message.Chat.Id]++
+	message.MessageId = server.lastId[message.Chat.Id]
+
+	if message.Date == 0 {
+		message.Date = int(server.now().Unix())
+	}
+
+	if server.messages[message.Chat.Id] == nil {
+		server.messages[message.Chat.Id] = map[int]*gobot.Message{}
+	}
+	server.messages[message.Chat.Id][message.MessageId] = message
+}
+
+// sendMessage creates a message sent by the bot with the given content fields.
+func (server *Server) sendMessage(request *Request, fields map[string]interface{}) (*gobot.Message, *gobot.Error) {
+	var chatId *gobot.ChatId
+
+	if !request.Param("chat_id", &chatId) || chatId == nil {
+		return nil, newError(http.StatusBadRequest, "Bad Request: chat_id is empty")
+	}
+	chat := server.chat(chatId)
+	fields["chat"] = chat
+	fields["from"] = server.Me
+
+	if raw, ok := request.Params["reply_markup"]; ok {
+		fields["reply_markup"] = raw
+	}
+	encoded, err := json.Marshal(fields)
+
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "Bad Request: "+err.Error())
+	}
+	var message *gobot.Message
+
+	if err := json.Unmarshal(encoded, &message); err != nil {
+		return nil, newError(http.StatusBadRequest, "Bad Request: "+err.Error())
+	}
+	var replyTo int
+	var replyParameters *gobot.ReplyParameters
+
+	if request.Param("reply_parameters", &replyParameters) && replyParameters != nil {
+		replyTo = replyParameters.MessageId
+	} else {
+		request.Param("reply_to_message_id", &replyTo)
+	}
+
+	if replyTo != 0 {
+		message.ReplyToMessage = server.findMessage(chat, replyTo)
+	}
+	server.store(message)
+	server.sent[chat.Id] = append(server.sent[chat.Id], message)
+	return message, nil
+}
+
+func (server *Server) editMessage(request *Request) (interface{}, *gobot.Error) {
+	var inlineMessageId string
+
+	if request.Param("inline_message_id", &inlineMessageId) && inlineMessageId != "" {
+		return true, nil
+	}
+	var chatId *gobot.ChatId
+	var messageId int
+	request.Param("chat_id", &chatId)
+	request.Param("message_id", &messageId)
+	message := server.findMessage(server.chat(chatId), messageId)
+
+	if message == nil {
+		return nil, newError(http.StatusBadRequest, "Bad Request: message to edit not found")
+	}
+	edited := *message
+	var replyMarkup *gobot.InlineKeyboardMarkup
+	request.Param("reply_markup", &replyMarkup)
+	edited.ReplyMarkup = replyMarkup
+
+	switch request.Method {
+	case "editMessageText":
+		request.Param("text", &edited.Text)
+	case "editMessageCaption":
+		edited.Caption = ""
+		request.Param("caption", &edited.Caption)
+	}
+	before, _ := json.Marshal(message)
+	after, _ := json.Marshal(&edited)
+
+	if string(before) == string(after) {
+		return nil, newError(http.StatusBadRequest, "Bad Request: message is not modified: specified new message content and reply markup are exactly the same as a current content and reply markup of the message")
+	}
+	edited.EditDate = int(server.now().Unix())
+	*message = edited
+	return message, nil
+}
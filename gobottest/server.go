@@ -0,0 +1,273 @@
+// Package gobottest provides a fake Telegram Bot API server, running in process, to test bots built with
+// gobot: it keeps chats and messages in memory, lets tests act as users by injecting updates and records
+// every request the bot makes.
+package gobottest
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+const Token = "123456:TEST-TOKEN"
+
+// Request is a request made by the bot to the fake server.
+type Request struct {
+	Method string
+	Params map[string]json.RawMessage
+	Body   []byte
+	Time   time.Time
+}
+
+// Decode unmarshals the params of the request into v, e.g. a *gobot.SendMessageParams. Fields with an
+// interface type, like ReplyMarkup, can't be decoded and must be read from Params.
+func (request *Request) Decode(v interface{}) error {
+	return json.Unmarshal(request.Body, v)
+}
+
+// Param unmarshals a single param of the request into v, reporting whether it was present.
+func (request *Request) Param(name string, v interface{}) bool {
+	raw, ok := request.Params[name]
+	return ok && json.Unmarshal(raw, v) == nil
+}
+
+type storedFile struct {
+	file    *gobot.File
+	content []byte
+}
+
+// Server is a fake Bot API server. It's safe for concurrent use.
+type Server struct {
+	Me             *gobot.User // The bot's user, returned by getMe and used as sender of the messages it sends
+	server         *httptest.Server
+	mutex          sync.Mutex
+	changed        *sync.Cond
+	closed         bool
+	now            func() time.Time
+	nextId         int // Last id given to a user, a chat, a file or a callback query
+	nextUpdate     int
+	nextMediaGroup int
+	updates        []*gobot.Update
+	requests       []*Request
+	chats          map[int]*gobot.Chat
+	messages       map[int]map[int]*gobot.Message
+	sent           map[int][]*gobot.Message
+	lastId         map[int]int
+	answers        map[string]*gobot.AnswerCallbackQueryParams
+	errors         map[string][]*gobot.Error
+	files          map[string]*storedFile
+}
+
+// NewServer starts a fake server. It must be closed with Close.
+func NewServer() *Server {
+	server := &Server{
+		Me:         &gobot.User{Id: 123456, IsBot: true, FirstName: "Test Bot", Username: "test_bot"},
+		now:        time.Now,
+		nextId:     1000,
+		nextUpdate: 1,
+		chats:      map[int]*gobot.Chat{},
+		messages:   map[int]map[int]*gobot.Message{},
+		sent:       map[int][]*gobot.Message{},
+		lastId:     map[int]int{},
+		answers:    map[string]*gobot.AnswerCallbackQueryParams{},
+		errors:     map[string][]*gobot.Error{},
+		files:      map[string]*storedFile{},
+	}
+	server.changed = sync.NewCond(&server.mutex)
+	server.server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+func (server *Server) Url() string {
+	return server.server.URL
+}
+
+// Close stops the server, releasing the pending getUpdates requests.
+func (server *Server) Close() {
+	server.mutex.Lock()
+	server.closed = true
+	server.changed.Broadcast()
+	server.mutex.Unlock()
+	server.server.Close()
+}
+
+// Bot returns a bot that talks to the fake server, with a short polling timeout. Its transport downloads
+// the files added with AddFile without going through HTTP.
+func (server *Server) Bot() *gobot.GoBot {
+	bot := gobot.InitTimeout(Token, 1)
+	bot.SetApiUrl(server.Url())
+	bot.SetTransport(fileTransport{Transport: bot.Transport(), server: server})
+	return bot
+}
+
+// fileTransport sends the requests to the server like the transport it wraps and implements
+// gobot.Downloader, reading the files from the server directly.
+type fileTransport struct {
+	gobot.Transport
+	server *Server
+}
+
+func (transport fileTransport) Download(filePath string, w io.Writer) error {
+	content, ok := transport.server.fileContent(filePath)
+
+	if !ok {
+		return newError(http.StatusNotFound, "Not Found: file "+filePath)
+	}
+	_, err := w.Write(content)
+	return err
+}
+
+func (server *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	filePrefix := "/file/bot" + Token + "/"
+
+	if strings.HasPrefix(r.URL.Path, filePrefix) {
+		server.serveFile(w, strings.TrimPrefix(r.URL.Path, filePrefix))
+		return
+	}
+	prefix := "/bot" + Token + "/"
+
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeError(w, &gobot.Error{ErrorCode: http.StatusUnauthorized, Description: "Unauthorized"})
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+
+	if err != nil {
+		writeError(w, &gobot.Error{ErrorCode: http.StatusBadRequest, Description: "Bad Request: " + err.Error()})
+		return
+	}
+	request := &Request{
+		Method: strings.TrimPrefix(r.URL.Path, prefix),
+		Params: map[string]json.RawMessage{},
+		Body:   body,
+		Time:   server.now(),
+	}
+
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &request.Params); err != nil {
+			writeError(w, &gobot.Error{ErrorCode: http.StatusBadRequest, Description: "Bad Request: invalid JSON"})
+			return
+		}
+	}
+
+	// getUpdates is polled continuously, so it isn't recorded
+	if request.Method == "getUpdates" {
+		server.getUpdates(w, request)
+		return
+	}
+	server.mutex.Lock()
+	server.requests = append(server.requests, request)
+	scripted := server.errors[request.Method]
+
+	if len(scripted) > 0 {
+		server.errors[request.Method] = scripted[1:]
+		server.changed.Broadcast()
+		server.mutex.Unlock()
+		writeError(w, scripted[0])
+		return
+	}
+	result, apiErr := server.handle(request)
+	var encoded []byte
+
+	if apiErr == nil {
+		encoded, err = json.Marshal(result)
+
+		if err != nil {
+			apiErr = newError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	server.changed.Broadcast()
+	server.mutex.Unlock()
+
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	writeResult(w, encoded)
+}
+
+func (server *Server) serveFile(w http.ResponseWriter, path string) {
+	content, ok := server.fileContent(path)
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write(content)
+}
+
+// fileContent returns the content of the file with the given path.
+func (server *Server) fileContent(path string) ([]byte, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	for _, stored := range server.files {
+		if stored.file.FilePath == path {
+			return stored.content, true
+		}
+	}
+	return nil, false
+}
+
+func writeResult(w http.ResponseWriter, encoded []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(gobot.Body{Ok: true, Result: encoded})
+}
+
+func writeError(w http.ResponseWriter, err *gobot.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.ErrorCode)
+	_ = json.NewEncoder(w).Encode(gobot.Body{
+		Ok:          false,
+		ErrorCode:   err.ErrorCode,
+		Description: err.Description,
+		Parameters:  err.Parameters,
+	})
+}
+
+func (server *Server) getUpdates(w http.ResponseWriter, request *Request) {
+	var offset, timeout int
+	request.Param("offset", &offset)
+	request.Param("timeout", &timeout)
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	timer := time.AfterFunc(time.Duration(timeout)*time.Second, func() {
+		server.mutex.Lock()
+		server.changed.Broadcast()
+		server.mutex.Unlock()
+	})
+	defer timer.Stop()
+	server.mutex.Lock()
+
+	for {
+		var pending []*gobot.Update
+
+		for _, update := range server.updates {
+			if update.UpdateId >= offset {
+				pending = append(pending, update)
+			}
+		}
+		server.updates = pending
+
+		if len(pending) > 0 || server.closed || !time.Now().Before(deadline) {
+			if pending == nil {
+				pending = []*gobot.Update{}
+			}
+			encoded, _ := json.Marshal(pending)
+			server.mutex.Unlock()
+			writeResult(w, encoded)
+			return
+		}
+		server.changed.Wait()
+	}
+}
+
+func newError(code int, description string) *gobot.Error {
+	return &gobot.Error{ErrorCode: code, Description: description}
+}
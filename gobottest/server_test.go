@@ -0,0 +1,141 @@
+package gobottest_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mattiabrandon/gobot"
+	"github.com/mattiabrandon/gobot/gobottest"
+)
+
+type likeData struct {
+	PostId int
+}
+
+// startBot starts a bot polling the server, with a /start handler sending a message with a like button.
+func startBot(t *testing.T, server *gobottest.Server) {
+	bot := server.Bot()
+	bot.SetLogger(nil)
+	bot.AddHandler(&gobot.Message{}, func(bot *gobot.GoBot, update *gobot.Update) {
+		if update.Message.Text != "/start" {
+			return
+		}
+		callbackData, err := bot.CallbackData("like", likeData{PostId: 42})
+
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		keyboard := gobot.NewInlineKeyboardMarkup(gobot.NewInlineKeyboardRow(gobot.NewInlineKeyboardButton("Like", callbackData, true)))
+
+		if _, err := bot.SendMessage(update.Message.NewSendMessage("Welcome!", keyboard)); err != nil {
+			t.Error(err)
+		}
+	})
+	bot.OnCallback("like", func(bot *gobot.GoBot, update *gobot.Update, data *likeData) {
+		text := "Liked"
+
+		if data.PostId != 42 {
+			text = "Unexpected post"
+		}
+		_, _ = bot.AnswerCallbackQuery(update.CallbackQuery.NewAnswerCallbackQuery(text, false))
+	})
+	go func() {
+		_ = bot.Loop(false)
+	}()
+	t.Cleanup(bot.Stop)
+}
+
+func TestStartAndCallback(t *testing.T) {
+	server := gobottest.NewServer()
+	defer server.Close()
+	startBot(t, server)
+	user := server.NewUser("Alice")
+	chat := server.PrivateChat(user)
+	server.SendMessage(user, chat, "/start")
+	message := server.ExpectMessage(t, chat.Id, "Welcome!")
+
+	if message.ReplyMarkup == nil || len(message.ReplyMarkup.InlineKeyboard) != 1 {
+		t.Fatalf("expected the message to have a keyboard, got %+v", message.ReplyMarkup)
+	}
+	queryId, ok := server.PressButtonText(user, message, "Like")
+
+	if !ok {
+		t.Fatal("expected the message to have a Like button")
+	}
+
+	if answer := server.ExpectCallbackAnswer(t, queryId); answer.Text != "Liked" {
+		t.Errorf("expected the answer %q, got %q", "Liked", answer.Text)
+	}
+}
+
+func TestExpiredCallback(t *testing.T) {
+	server := gobottest.NewServer()
+	defer server.Close()
+	startBot(t, server)
+	user := server.NewUser("Bob")
+	chat := server.PrivateChat(user)
+	server.SendMessage(user, chat, "/start")
+	message := server.ExpectMessage(t, chat.Id, "Welcome!")
+	queryId := server.PressButton(user, message, "like:#missing")
+	answer := server.ExpectCallbackAnswer(t, queryId)
+
+	if answer.Text != gobot.CallbackExpiredText || !answer.ShowAlert {
+		t.Errorf("expected an alert with %q, got %+v", gobot.CallbackExpiredText, answer)
+	}
+}
+
+func TestMediaGroupIds(t *testing.T) {
+	server := gobottest.NewServer()
+	defer server.Close()
+	bot := server.Bot()
+	bot.SetLogger(nil)
+	user := server.NewUser("Carol")
+	chat := server.PrivateChat(user)
+	var groupIds []string
+
+	for i := 0; i < 2; i++ {
+		messages, err := bot.SendMediaGroup(gobot.SendMediaGroupParams{
+			ChatId: gobot.NewChatId(chat.Id),
+			Media:  []gobot.InputMedia{gobot.NewInputMediaPhoto("photo1"), gobot.NewInputMediaPhoto("photo2")},
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(messages) != 2 || messages[0].MediaGroupId == "" || messages[0].MediaGroupId != messages[1].MediaGroupId {
+			t.Fatalf("expected 2 messages in the same media group, got %+v", messages)
+		}
+		groupIds = append(groupIds, messages[0].MediaGroupId)
+	}
+
+	if groupIds[0] == groupIds[1] {
+		t.Errorf("expected different media group ids, got %q twice", groupIds[0])
+	}
+}
+
+func TestDownloadFile(t *testing.T) {
+	server := gobottest.NewServer()
+	defer server.Close()
+	fileId := server.AddFile([]byte("content"))
+	httpBot := gobot.InitTimeout(gobottest.Token, 1)
+	httpBot.SetApiUrl(server.Url())
+
+	for _, bot := range []*gobot.GoBot{server.Bot(), httpBot} {
+		bot.SetLogger(nil)
+		content, err := bot.GetFileContent(fileId)
+
+		if err != nil {
+			t.Fatal(err)
+		} else if string(content) != "content" {
+			t.Errorf("expected the content of the file, got %q", content)
+		}
+		var apiErr *gobot.Error
+
+		if err := bot.DownloadFile("documents/missing", ioutil.Discard); !errors.As(err, &apiErr) || apiErr.ErrorCode != 404 {
+			t.Errorf("expected a 404 error for a missing file, got %v", err)
+		}
+	}
+}
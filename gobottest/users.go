@@ -0,0 +1,131 @@
+package gobottest
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+func (server *Server) NewUser(firstName string) *gobot.User {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.nextId++
+	return &gobot.User{Id: server.nextId, FirstName: firstName, LanguageCode: "en"}
+}
+
+// PrivateChat returns the private chat between the bot and the user.
+func (server *Server) PrivateChat(user *gobot.User) *gobot.Chat {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	chat, ok := server.chats[user.Id]
+
+	if !ok {
+		chat = &gobot.Chat{Id: user.Id, Type: "private", FirstName: user.FirstName, LastName: user.LastName, Username: user.Username}
+		server.chats[user.Id] = chat
+	}
+	return chat
+}
+
+func (server *Server) NewGroup(title string) *gobot.Chat {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.nextId++
+	chat := &gobot.Chat{Id: -server.nextId, Type: "supergroup", Title: title}
+	server.chats[chat.Id] = chat
+	return chat
+}
+
+// AddFile stores a file that the bot can get with getFile and download, returning its file id.
+func (server *Server) AddFile(content []byte) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.nextId++
+	fileId := "file" + strconv.Itoa(server.nextId)
+	server.files[fileId] = &storedFile{
+		file: &gobot.File{
+			FileId:       fileId,
+			FileUniqueId: fileId,
+			FileSize:     len(content),
+			FilePath:     "documents/" + fileId,
+		},
+		content: content,
+	}
+	return fileId
+}
+
+// Inject queues an update for the bot, assigning it the next update id.
+func (server *Server) Inject(update *gobot.Update) *gobot.Update {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	update.UpdateId = server.nextUpdate
+	server.nextUpdate++
+	server.updates = append(server.updates, update)
+	server.changed.Broadcast()
+	return update
+}
+
+// SendMessage simulates the user sending a message to the chat. Commands at the start of the text get
+// their bot_command entity.
+func (server *Server) SendMessage(from *gobot.User, chat *gobot.Chat, text string) *gobot.Message {
+	message := &gobot.Message{From: from, Chat: chat, Text: text}
+
+	if strings.HasPrefix(text, "/") {
+		command := strings.Fields(text)[0]
+		message.Entities = []*gobot.MessageEntity{{Type: "bot_command", Length: len(utf16.Encode([]rune(command)))}}
+	}
+	return server.SendUserMessage(message)
+}
+
+// SendUserMessage simulates the user sending an arbitrary message, e.g. a photo, whose sender and chat must be set.
+func (server *Server) SendUserMessage(message *gobot.Message) *gobot.Message {
+	server.mutex.Lock()
+	server.chats[message.Chat.Id] = message.Chat
+	server.store(message)
+	copied := *message
+	server.mutex.Unlock()
+	server.Inject(&gobot.Update{Message: &copied})
+	return message
+}
+
+// PressButton simulates the user pressing an inline keyboard button with the given callback data, on a
+// message sent by the bot, and returns the id of the callback query.
+func (server *Server) PressButton(from *gobot.User, message *gobot.Message, callbackData string) string {
+	server.mutex.Lock()
+	server.nextId++
+	id := strconv.Itoa(server.nextId)
+	copied := *message
+
+	if stored := server.findMessage(message.Chat, message.MessageId); stored != nil {
+		copied = *stored
+	}
+	server.mutex.Unlock()
+	server.Inject(&gobot.Update{CallbackQuery: &gobot.CallbackQuery{
+		Id:           id,
+		From:         from,
+		Message:      &copied,
+		ChatInstance: strconv.Itoa(message.Chat.Id),
+		Data:         callbackData,
+	}})
+	return id
+}
+
+// PressButtonText presses the button with the given text on the message's inline keyboard, reporting whether
+// it was found.
+func (server *Server) PressButtonText(from *gobot.User, message *gobot.Message, text string) (string, bool) {
+	current := server.Message(message.Chat.Id, message.MessageId)
+
+	if current == nil || current.ReplyMarkup == nil {
+		return "", false
+	}
+
+	for _, row := range current.ReplyMarkup.InlineKeyboard {
+		for _, button := range row {
+			if button.Text == text && button.CallbackData != "" {
+				return server.PressButton(from, current, button.CallbackData), true
+			}
+		}
+	}
+	return "", false
+}
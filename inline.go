@@ -0,0 +1,219 @@
+package gobot
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	inlineQueryPageSize         = 50
+	inlineQueryDefaultCacheTime = 300
+)
+
+// InlineHandler answers inline queries with the results of a provider, paginating them by offset in pages
+// of 50 results and caching them locally for CacheTime seconds, as Telegram does on its servers.
+type InlineHandler struct {
+	Results    func(bot *GoBot, query *InlineQuery) ([]InlineQueryResult, error)      // Provides all the results for a query
+	Chosen     func(bot *GoBot, chosen *ChosenInlineResult, result InlineQueryResult) // Optional. Called with the result chosen by a user, nil if no longer cached. Requires inline feedback to be enabled with @BotFather
+	CacheTime  int                                                                    // Optional. Seconds the results are cached for, both locally and by Telegram. Defaults to 300
+	IsPersonal bool                                                                   // Optional. True, if results depend on the user and must be cached per user
+	Debounce   time.Duration                                                          // Optional. Time to wait, on the clock of the bot, for the user to stop typing before computing the results
+	OnError    func(update *Update, err error)                                        // Optional. Called when the results can't be provided or sent
+	mutex      sync.Mutex
+	cache      map[string]*inlineCacheEntry
+	latest     map[int]string
+}
+
+type inlineCacheEntry struct {
+	results []InlineQueryResult
+	err     error
+	expires time.Time
+	done    chan struct{}
+}
+
+// OnInlineQuery registers the handler for inline queries and, if Chosen is set, for chosen inline results.
+func (bot *GoBot) OnInlineQuery(handler *InlineHandler) {
+	handler.cache = map[string]*inlineCacheEntry{}
+	handler.latest = map[int]string{}
+	bot.AddHandler(&InlineQuery{}, handler.handleQuery)
+
+	if handler.Chosen != nil {
+		bot.AddHandler(&ChosenInlineResult{}, handler.handleChosen)
+	}
+}
+
+func (handler *InlineHandler) cacheTime() int {
+	if handler.CacheTime <= 0 {
+		return inlineQueryDefaultCacheTime
+	}
+	return handler.CacheTime
+}
+
+func (handler *InlineHandler) cacheKey(query string, user *User) string {
+	if handler.IsPersonal && user != nil {
+		return strconv.Itoa(user.Id) + ":" + query
+	}
+	return ":" + query
+}
+
+func (handler *InlineHandler) handleQuery(bot *GoBot, update *Update) {
+	query := update.InlineQuery
+
+	if handler.Debounce > 0 && query.Offset == "" && query.From != nil {
+		handler.mutex.Lock()
+		handler.latest[query.From.Id] = query.Id
+		handler.mutex.Unlock()
+		// The update is handled once the query is registered, so the span of the answer is a new one
+		debouncedBot := bot.WithContext(context.Background())
+		debouncedBot.update = update
+		bot.clock.AfterFunc(handler.Debounce, func() {
+			handler.answerDebounced(debouncedBot, update)
+		})
+		return
+	}
+	handler.answer(bot, update)
+}
+
+// answerDebounced answers the query if the user hasn't sent a newer one in the meantime.
+func (handler *InlineHandler) answerDebounced(bot *GoBot, update *Update) {
+	query := update.InlineQuery
+	handler.mutex.Lock()
+	latest := handler.latest[query.From.Id] == query.Id
+
+	if latest {
+		delete(handler.latest, query.From.Id)
+	}
+	handler.mutex.Unlock()
+
+	if !latest {
+		return
+	}
+	ctx, span := bot.startSpan("gobot.HandleInlineQuery")
+	defer span.End()
+	span.SetAttribute("telegram.update_id", update.UpdateId)
+
+	if bot.manager != nil {
+		span.SetAttribute("telegram.bot", bot.name)
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			bot.logger.Error("Panic while answering inline query", "update_id", update.UpdateId,
+				"panic", recovered, "stack", string(debug.Stack()))
+			span.SetError(fmt.Errorf("panic: %v", recovered))
+		}
+	}()
+	handler.answer(bot.WithContext(ctx), update)
+}
+
+// answer sends the page of results requested by the query.
+func (handler *InlineHandler) answer(bot *GoBot, update *Update) {
+	query := update.InlineQuery
+	results, err := handler.results(bot, query)
+
+	if err != nil {
+		if handler.OnError != nil {
+			handler.OnError(update, err)
+		}
+		return
+	}
+	offset, _ := strconv.Atoi(query.Offset)
+
+	if offset < 0 || offset > len(results) {
+		offset = len(results)
+	}
+	end := offset + inlineQueryPageSize
+	var nextOffset string
+
+	if end < len(results) {
+		nextOffset = strconv.Itoa(end)
+	} else {
+		end = len(results)
+	}
+
+	if _, err := bot.AnswerInlineQuery(AnswerInlineQueryParams{
+		InlineQueryId: query.Id,
+		Results:       results[offset:end],
+		CacheTime:     handler.cacheTime(),
+		IsPersonal:    handler.IsPersonal,
+		NextOffset:    nextOffset,
+	}); err != nil && handler.OnError != nil {
+		handler.OnError(update, err)
+	}
+}
+
+// results returns the cached results of the query or computes them, once for identical concurrent queries.
+func (handler *InlineHandler) results(bot *GoBot, query *InlineQuery) ([]InlineQueryResult, error) {
+	key := handler.cacheKey(query.Query, query.From)
+	now := bot.clock.Now()
+	handler.mutex.Lock()
+	entry, ok := handler.cache[key]
+
+	if !ok || isClosed(entry.done) && entry.expires.Before(now) {
+		for cachedKey, cached := range handler.cache {
+			if isClosed(cached.done) && cached.expires.Before(now) {
+				delete(handler.cache, cachedKey)
+			}
+		}
+		entry = &inlineCacheEntry{done: make(chan struct{})}
+		handler.cache[key] = entry
+		handler.mutex.Unlock()
+		entry.results, entry.err = handler.Results(bot, query)
+		entry.expires = bot.clock.Now().Add(time.Duration(handler.cacheTime()) * time.Second)
+
+		if entry.err != nil {
+			entry.expires = bot.clock.Now()
+		}
+		close(entry.done)
+		return entry.results, entry.err
+	}
+	handler.mutex.Unlock()
+	<-entry.done
+	return entry.results, entry.err
+}
+
+func isClosed(done chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (handler *InlineHandler) handleChosen(bot *GoBot, update *Update) {
+	chosen := update.ChosenInlineResult
+	handler.mutex.Lock()
+	entry, ok := handler.cache[handler.cacheKey(chosen.Query, chosen.From)]
+	handler.mutex.Unlock()
+	var chosenResult InlineQueryResult
+
+	if ok && isClosed(entry.done) {
+		for _, result := range entry.results {
+			if InlineQueryResultId(result) == chosen.ResultId {
+				chosenResult = result
+				break
+			}
+		}
+	}
+	handler.Chosen(bot, chosen, chosenResult)
+}
+
+// InlineQueryResultId returns the Id of any InlineQueryResult.
+func InlineQueryResultId(result InlineQueryResult) string {
+	v := reflect.Indirect(reflect.ValueOf(result))
+
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	id := v.FieldByName("Id")
+
+	if !id.IsValid() || id.Kind() != reflect.String {
+		return ""
+	}
+	return id.String()
+}
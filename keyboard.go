@@ -0,0 +1,321 @@
+package gobot
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+const keyboardPlaceholderMaxLength = 64
+
+var (
+	ErrKeyboardButtonNoText      = errors.New("button text must not be empty")
+	ErrKeyboardButtonNoAction    = errors.New("inline button must have one of url, login_url, callback_data, web_app, switch_inline_query, switch_inline_query_current_chat, switch_inline_query_chosen_chat, callback_game or pay set")
+	ErrKeyboardButtonManyActions = errors.New("button must have only one action set")
+	ErrKeyboardButtonNotFirst    = errors.New("callback_game and pay buttons must be the first button in the first row")
+	ErrKeyboardRowEmpty          = errors.New("keyboard rows must not be empty")
+	ErrKeyboardPlaceholder       = errors.New("input field placeholder must be at most 64 characters")
+)
+
+// NewInlineKeyboardGrid wraps the buttons into rows of width buttons each, the last row holding the rest.
+func NewInlineKeyboardGrid(width int, buttons ...*InlineKeyboardButton) [][]*InlineKeyboardButton {
+	var rows [][]*InlineKeyboardButton
+
+	if width <= 0 {
+		width = 1
+	}
+
+	for len(buttons) > width {
+		rows = append(rows, NewInlineKeyboardRow(buttons[:width]...))
+		buttons = buttons[width:]
+	}
+
+	if len(buttons) > 0 {
+		rows = append(rows, NewInlineKeyboardRow(buttons...))
+	}
+	return rows
+}
+
+// NewKeyboardGrid wraps the buttons into rows of width buttons each, the last row holding the rest.
+func NewKeyboardGrid(width int, buttons ...*KeyboardButton) [][]*KeyboardButton {
+	var rows [][]*KeyboardButton
+
+	if width <= 0 {
+		width = 1
+	}
+
+	for len(buttons) > width {
+		rows = append(rows, NewKeyboardRow(buttons[:width]...))
+		buttons = buttons[width:]
+	}
+
+	if len(buttons) > 0 {
+		rows = append(rows, NewKeyboardRow(buttons...))
+	}
+	return rows
+}
+
+// InlineKeyboardBuilder lays out inline keyboard buttons. Buttons are added to the current row, which
+// is wrapped once it holds Width buttons; Row starts a new row explicitly.
+type InlineKeyboardBuilder struct {
+	rows  [][]*InlineKeyboardButton
+	width int
+}
+
+func NewInlineKeyboard(width int) *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{width: width}
+}
+
+func (builder *InlineKeyboardBuilder) Add(buttons ...*InlineKeyboardButton) *InlineKeyboardBuilder {
+	for _, button := range buttons {
+		last := len(builder.rows) - 1
+
+		if last < 0 || builder.width > 0 && len(builder.rows[last]) >= builder.width {
+			builder.rows = append(builder.rows, nil)
+			last++
+		}
+		builder.rows[last] = append(builder.rows[last], button)
+	}
+	return builder
+}
+
+func (builder *InlineKeyboardBuilder) Row(buttons ...*InlineKeyboardButton) *InlineKeyboardBuilder {
+	builder.rows = append(builder.rows, nil)
+	return builder.Add(buttons...)
+}
+
+func (builder *InlineKeyboardBuilder) Url(text string, url string) *InlineKeyboardBuilder {
+	return builder.Add(NewInlineKeyboardButton(text, url, false))
+}
+
+func (builder *InlineKeyboardBuilder) Callback(text string, callbackData string) *InlineKeyboardBuilder {
+	return builder.Add(NewInlineKeyboardButton(text, callbackData, true))
+}
+
+func (builder *InlineKeyboardBuilder) LoginUrl(text string, loginUrl *LoginUrl) *InlineKeyboardBuilder {
+	return builder.Add(NewInlineKeyboardButtonLoginUrl(text, loginUrl))
+}
+
+func (builder *InlineKeyboardBuilder) SwitchInlineQuery(text string, query string, currentChat bool) *InlineKeyboardBuilder {
+	return builder.Add(NewInlineKeyboardButtonSwitchInlineQuery(text, query, currentChat))
+}
+
+func (builder *InlineKeyboardBuilder) CallbackGame(text string) *InlineKeyboardBuilder {
+	return builder.Add(NewInlineKeyboardButtonCallbackGame(text))
+}
+
+func (builder *InlineKeyboardBuilder) Pay(text string) *InlineKeyboardBuilder {
+	return builder.Add(NewInlineKeyboardButtonPay(text))
+}
+
+// Markup returns the keyboard without validating it.
+func (builder *InlineKeyboardBuilder) Markup() *InlineKeyboardMarkup {
+	var rows [][]*InlineKeyboardButton
+
+	for _, row := range builder.rows {
+		if len(row) > 0 {
+			rows = append(rows, row)
+		}
+	}
+	return NewInlineKeyboardMarkup(rows...)
+}
+
+// Build returns the keyboard, or the first error found by Validate.
+func (builder *InlineKeyboardBuilder) Build() (*InlineKeyboardMarkup, error) {
+	markup := builder.Markup()
+	return markup, markup.Validate()
+}
+
+// ReplyKeyboardBuilder lays out reply keyboard buttons like InlineKeyboardBuilder does.
+type ReplyKeyboardBuilder struct {
+	markup *ReplyKeyboardMarkup
+	rows   [][]*KeyboardButton
+	width  int
+}
+
+// NewReplyKeyboard creates a builder for a keyboard which, by default, is resized to fit its buttons.
+func NewReplyKeyboard(width int) *ReplyKeyboardBuilder {
+	return &ReplyKeyboardBuilder{markup: NewReplyKeyboardMarkup(), width: width}
+}
+
+func (builder *ReplyKeyboardBuilder) Add(buttons ...*KeyboardButton) *ReplyKeyboardBuilder {
+	for _, button := range buttons {
+		last := len(builder.rows) - 1
+
+		if last < 0 || builder.width > 0 && len(builder.rows[last]) >= builder.width {
+			builder.rows = append(builder.rows, nil)
+			last++
+		}
+		builder.rows[last] = append(builder.rows[last], button)
+	}
+	return builder
+}
+
+func (builder *ReplyKeyboardBuilder) Row(buttons ...*KeyboardButton) *ReplyKeyboardBuilder {
+	builder.rows = append(builder.rows, nil)
+	return builder.Add(buttons...)
+}
+
+func (builder *ReplyKeyboardBuilder) Text(text string) *ReplyKeyboardBuilder {
+	return builder.Add(NewKeyboardButton(text))
+}
+
+func (builder *ReplyKeyboardBuilder) Contact(text string) *ReplyKeyboardBuilder {
+	return builder.Add(NewKeyboardButtonContact(text))
+}
+
+func (builder *ReplyKeyboardBuilder) Location(text string) *ReplyKeyboardBuilder {
+	return builder.Add(NewKeyboardButtonLocation(text))
+}
+
+func (builder *ReplyKeyboardBuilder) Poll(text string, pollType string) *ReplyKeyboardBuilder {
+	return builder.Add(NewKeyboardButtonPoll(text, pollType))
+}
+
+func (builder *ReplyKeyboardBuilder) Resize(resize bool) *ReplyKeyboardBuilder {
+	builder.markup.ResizeKeyboard = resize
+	return builder
+}
+
+func (builder *ReplyKeyboardBuilder) OneTime() *ReplyKeyboardBuilder {
+	builder.markup.OneTimeKeyboard = true
+	return builder
+}
+
+func (builder *ReplyKeyboardBuilder) Selective() *ReplyKeyboardBuilder {
+	builder.markup.Selective = true
+	return builder
+}
+
+func (builder *ReplyKeyboardBuilder) Placeholder(inputFieldPlaceholder string) *ReplyKeyboardBuilder {
+	builder.markup.InputFieldPlaceholder = inputFieldPlaceholder
+	return builder
+}
+
+// Markup returns the keyboard without validating it.
+func (builder *ReplyKeyboardBuilder) Markup() *ReplyKeyboardMarkup {
+	markup := *builder.markup
+	markup.Keyboard = [][]*KeyboardButton{}
+
+	for _, row := range builder.rows {
+		if len(row) > 0 {
+			markup.Keyboard = append(markup.Keyboard, row)
+		}
+	}
+	return &markup
+}
+
+// Build returns the keyboard, or the first error found by Validate.
+func (builder *ReplyKeyboardBuilder) Build() (*ReplyKeyboardMarkup, error) {
+	markup := builder.Markup()
+	return markup, markup.Validate()
+}
+
+// Validate checks that the button has a text and exactly one action, and that its callback data is 1-64 bytes.
+// A switch_inline_query with an empty query can't be validated, as it isn't distinguishable from an unset one.
+func (button *InlineKeyboardButton) Validate() error {
+	if button.Text == "" {
+		return ErrKeyboardButtonNoText
+	}
+	actions := 0
+
+	for _, set := range []bool{
+		button.Url != "",
+		button.LoginUrl != nil,
+		button.CallbackData != "",
+		button.WebApp != nil,
+		button.SwitchInlineQuery != "",
+		button.SwitchInlineQueryCurrentChat != "",
+		button.SwitchInlineQueryChosenChat != nil,
+		button.CallbackGame != nil,
+		button.Pay,
+	} {
+		if set {
+			actions++
+		}
+	}
+
+	if actions == 0 {
+		return ErrKeyboardButtonNoAction
+	}
+
+	if actions > 1 {
+		return ErrKeyboardButtonManyActions
+	}
+
+	if len(button.CallbackData) > callbackDataMaxLength {
+		return ErrCallbackDataTooLong
+	}
+	return nil
+}
+
+func (markup *InlineKeyboardMarkup) Validate() error {
+	for i, row := range markup.InlineKeyboard {
+		if len(row) == 0 {
+			return fmt.Errorf("row %d: %w", i, ErrKeyboardRowEmpty)
+		}
+
+		for j, button := range row {
+			if err := button.Validate(); err != nil {
+				return fmt.Errorf("row %d, button %d: %w", i, j, err)
+			}
+
+			if (button.CallbackGame != nil || button.Pay) && (i != 0 || j != 0) {
+				return fmt.Errorf("row %d, button %d: %w", i, j, ErrKeyboardButtonNotFirst)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks that the button has a text and at most one action: a request or a Web App.
+func (button *KeyboardButton) Validate() error {
+	if button.Text == "" {
+		return ErrKeyboardButtonNoText
+	}
+	requests := 0
+
+	for _, set := range []bool{
+		button.RequestUsers != nil,
+		button.RequestChat != nil,
+		button.RequestContact,
+		button.RequestLocation,
+		button.RequestPoll != nil,
+		button.WebApp != nil,
+	} {
+		if set {
+			requests++
+		}
+	}
+
+	if requests > 1 {
+		return ErrKeyboardButtonManyActions
+	}
+	return nil
+}
+
+func (markup *ReplyKeyboardMarkup) Validate() error {
+	if utf8.RuneCountInString(markup.InputFieldPlaceholder) > keyboardPlaceholderMaxLength {
+		return ErrKeyboardPlaceholder
+	}
+
+	for i, row := range markup.Keyboard {
+		if len(row) == 0 {
+			return fmt.Errorf("row %d: %w", i, ErrKeyboardRowEmpty)
+		}
+
+		for j, button := range row {
+			if err := button.Validate(); err != nil {
+				return fmt.Errorf("row %d, button %d: %w", i, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (forceReply *ForceReply) Validate() error {
+	if utf8.RuneCountInString(forceReply.InputFieldPlaceholder) > keyboardPlaceholderMaxLength {
+		return ErrKeyboardPlaceholder
+	}
+	return nil
+}
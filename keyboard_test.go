@@ -0,0 +1,96 @@
+package gobot
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestInlineKeyboardButtonValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		button InlineKeyboardButton
+		err    error
+	}{
+		{"url", InlineKeyboardButton{Url: "https://example.com"}, nil},
+		{"login url", InlineKeyboardButton{LoginUrl: &LoginUrl{Url: "https://example.com"}}, nil},
+		{"callback data", InlineKeyboardButton{CallbackData: "data"}, nil},
+		{"web app", InlineKeyboardButton{WebApp: &WebAppInfo{Url: "https://example.com"}}, nil},
+		{"switch inline query", InlineKeyboardButton{SwitchInlineQuery: "query"}, nil},
+		{"switch inline query current chat", InlineKeyboardButton{SwitchInlineQueryCurrentChat: "query"}, nil},
+		{"switch inline query chosen chat", InlineKeyboardButton{SwitchInlineQueryChosenChat: &SwitchInlineQueryChosenChat{}}, nil},
+		{"callback game", InlineKeyboardButton{CallbackGame: &CallbackGame{}}, nil},
+		{"pay", InlineKeyboardButton{Pay: true}, nil},
+		{"no action", InlineKeyboardButton{}, ErrKeyboardButtonNoAction},
+		{"url and web app", InlineKeyboardButton{Url: "https://example.com", WebApp: &WebAppInfo{}}, ErrKeyboardButtonManyActions},
+		{"callback data and chosen chat", InlineKeyboardButton{CallbackData: "data", SwitchInlineQueryChosenChat: &SwitchInlineQueryChosenChat{}}, ErrKeyboardButtonManyActions},
+		{"long callback data", InlineKeyboardButton{CallbackData: strings.Repeat("x", 65)}, ErrCallbackDataTooLong},
+	}
+
+	for _, test := range tests {
+		button := test.button
+		button.Text = "text"
+
+		if err := button.Validate(); !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+		}
+	}
+
+	if err := (&InlineKeyboardButton{Url: "https://example.com"}).Validate(); !errors.Is(err, ErrKeyboardButtonNoText) {
+		t.Errorf("expected ErrKeyboardButtonNoText, got %v", err)
+	}
+}
+
+func TestKeyboardButtonValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		button KeyboardButton
+		err    error
+	}{
+		{"text", KeyboardButton{}, nil},
+		{"request users", KeyboardButton{RequestUsers: &KeyboardButtonRequestUsers{RequestId: 1}}, nil},
+		{"request chat", KeyboardButton{RequestChat: &KeyboardButtonRequestChat{RequestId: 1}}, nil},
+		{"request contact", KeyboardButton{RequestContact: true}, nil},
+		{"request location", KeyboardButton{RequestLocation: true}, nil},
+		{"request poll", KeyboardButton{RequestPoll: &KeyboardButtonPollType{}}, nil},
+		{"web app", KeyboardButton{WebApp: &WebAppInfo{Url: "https://example.com"}}, nil},
+		{"contact and location", KeyboardButton{RequestContact: true, RequestLocation: true}, ErrKeyboardButtonManyActions},
+		{"users and chat", KeyboardButton{RequestUsers: &KeyboardButtonRequestUsers{}, RequestChat: &KeyboardButtonRequestChat{}}, ErrKeyboardButtonManyActions},
+		{"poll and web app", KeyboardButton{RequestPoll: &KeyboardButtonPollType{}, WebApp: &WebAppInfo{}}, ErrKeyboardButtonManyActions},
+	}
+
+	for _, test := range tests {
+		button := test.button
+		button.Text = "text"
+
+		if err := button.Validate(); !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+		}
+	}
+
+	if err := (&KeyboardButton{RequestContact: true}).Validate(); !errors.Is(err, ErrKeyboardButtonNoText) {
+		t.Errorf("expected ErrKeyboardButtonNoText, got %v", err)
+	}
+}
+
+func TestInlineKeyboardMarkupValidate(t *testing.T) {
+	pay := &InlineKeyboardButton{Text: "Pay", Pay: true}
+	url := &InlineKeyboardButton{Text: "Site", Url: "https://example.com"}
+	tests := []struct {
+		name     string
+		keyboard [][]*InlineKeyboardButton
+		err      error
+	}{
+		{"pay first", [][]*InlineKeyboardButton{{pay, url}}, nil},
+		{"pay second", [][]*InlineKeyboardButton{{url, pay}}, ErrKeyboardButtonNotFirst},
+		{"empty row", [][]*InlineKeyboardButton{{url}, {}}, ErrKeyboardRowEmpty},
+	}
+
+	for _, test := range tests {
+		markup := &InlineKeyboardMarkup{InlineKeyboard: test.keyboard}
+
+		if err := markup.Validate(); !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+		}
+	}
+}
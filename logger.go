@@ -0,0 +1,185 @@
+package gobot
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Logger receives the logs of the bot: a message followed by key-value pairs, e.g. "method", "sendMessage".
+// *slog.Logger implements it.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+type stdLogger struct {
+	out     *log.Logger
+	verbose bool
+}
+
+// NewStdLogger returns a logger writing to out as "LEVEL message key=value...". Debug logs, e.g. one for
+// every request, are written only if verbose.
+func NewStdLogger(out *log.Logger, verbose bool) Logger {
+	return &stdLogger{out: out, verbose: verbose}
+}
+
+func (logger *stdLogger) Debug(msg string, args ...interface{}) {
+	if logger.verbose {
+		logger.write("DEBUG", msg, args)
+	}
+}
+
+func (logger *stdLogger) Info(msg string, args ...interface{}) {
+	logger.write("INFO", msg, args)
+}
+
+func (logger *stdLogger) Warn(msg string, args ...interface{}) {
+	logger.write("WARN", msg, args)
+}
+
+func (logger *stdLogger) Error(msg string, args ...interface{}) {
+	logger.write("ERROR", msg, args)
+}
+
+func (logger *stdLogger) write(level string, msg string, args []interface{}) {
+	var builder strings.Builder
+	builder.WriteString(level + " " + msg)
+
+	for i := 0; i+1 < len(args); i += 2 {
+		value := fmt.Sprint(args[i+1])
+
+		if value == "" || strings.ContainsAny(value, " \"=\n") {
+			value = strconv.Quote(value)
+		}
+		fmt.Fprintf(&builder, " %v=%s", args[i], value)
+	}
+	logger.out.Println(builder.String())
+}
+
+type discardLogger struct{}
+
+func (discardLogger) Debug(string, ...interface{}) {}
+func (discardLogger) Info(string, ...interface{})  {}
+func (discardLogger) Warn(string, ...interface{})  {}
+func (discardLogger) Error(string, ...interface{}) {}
+
+// redactingLogger replaces the token of the bot in the logs, e.g. in the urls of errors, before passing them on.
+type redactingLogger struct {
+	next     Logger
+	replacer *strings.Replacer
+}
+
+func newRedactingLogger(next Logger, token string) Logger {
+	if token == "" {
+		return next
+	}
+	return &redactingLogger{next: next, replacer: strings.NewReplacer(token, "<token>")}
+}
+
+func (logger *redactingLogger) Debug(msg string, args ...interface{}) {
+	logger.next.Debug(logger.replacer.Replace(msg), logger.redact(args)...)
+}
+
+func (logger *redactingLogger) Info(msg string, args ...interface{}) {
+	logger.next.Info(logger.replacer.Replace(msg), logger.redact(args)...)
+}
+
+func (logger *redactingLogger) Warn(msg string, args ...interface{}) {
+	logger.next.Warn(logger.replacer.Replace(msg), logger.redact(args)...)
+}
+
+func (logger *redactingLogger) Error(msg string, args ...interface{}) {
+	logger.next.Error(logger.replacer.Replace(msg), logger.redact(args)...)
+}
+
+// redact replaces the token in the args, which are formatted as strings unless they're nil, booleans or
+// numbers, e.g. durations, so that the token can't slip through a struct or a pointer.
+func (logger *redactingLogger) redact(args []interface{}) []interface{} {
+	redacted := make([]interface{}, len(args))
+
+	for i, arg := range args {
+		if arg == nil {
+			continue
+		}
+
+		switch reflect.TypeOf(arg).Kind() {
+		case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+			redacted[i] = arg
+		default:
+			redacted[i] = logger.replacer.Replace(fmt.Sprint(arg))
+		}
+	}
+	return redacted
+}
+
+// namedLogger adds the name of a bot run by a Manager to the logs, before passing them on.
+type namedLogger struct {
+	next Logger
+	name string
+}
+
+func newNamedLogger(next Logger, name string) Logger {
+	if named, ok := next.(*namedLogger); ok {
+		next = named.next
+	}
+	return &namedLogger{next: next, name: name}
+}
+
+func (logger *namedLogger) Debug(msg string, args ...interface{}) {
+	logger.next.Debug(msg, append([]interface{}{"bot", logger.name}, args...)...)
+}
+
+func (logger *namedLogger) Info(msg string, args ...interface{}) {
+	logger.next.Info(msg, append([]interface{}{"bot", logger.name}, args...)...)
+}
+
+func (logger *namedLogger) Warn(msg string, args ...interface{}) {
+	logger.next.Warn(msg, append([]interface{}{"bot", logger.name}, args...)...)
+}
+
+func (logger *namedLogger) Error(msg string, args ...interface{}) {
+	logger.next.Error(msg, append([]interface{}{"bot", logger.name}, args...)...)
+}
+
+// SetLogger makes the bot log through logger, nil silences the bot.
+func (bot *GoBot) SetLogger(logger Logger) {
+	if logger == nil {
+		logger = discardLogger{}
+	}
+	bot.logger = newRedactingLogger(logger, bot.token)
+
+	if bot.name != "" {
+		bot.logger = newNamedLogger(bot.logger, bot.name)
+	}
+}
+
+// Logger returns the logger of the bot, which logs through the standard log package unless SetLogger is called.
+func (bot *GoBot) Logger() Logger {
+	return bot.logger
+}
+
+// chatIdOf returns the chat_id of the params, if they have one, for the logs.
+func chatIdOf(params interface{}) (string, bool) {
+	value := reflect.Indirect(reflect.ValueOf(params))
+
+	if value.Kind() != reflect.Struct {
+		return "", false
+	}
+	field := value.FieldByName("ChatId")
+
+	if !field.IsValid() || field.IsZero() {
+		return "", false
+	} else if chatId, ok := field.Interface().(*ChatId); ok {
+		return chatId.String(), true
+	} else if field.Kind() == reflect.Int {
+		return strconv.FormatInt(field.Int(), 10), true
+	}
+	return "", false
+}
@@ -0,0 +1,238 @@
+package gobot
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrManagerDuplicateBot  = errors.New("a bot with this name is already managed")
+	ErrManagerInvalidName   = errors.New("the name of a bot must be a path element that needs no escaping")
+	ErrManagerNoSecretToken = errors.New("the manager needs a secret token to receive updates through webhooks")
+)
+
+// Manager runs many bots in one process, either polling for their updates or receiving them on a single
+// webhook server, under the path named after each bot. Its handlers and middlewares are shared by all the
+// bots: the middlewares wrap the ones of each bot and the handlers run after the ones of each bot. The
+// handlers can tell which bot got the update with bot.Name.
+type Manager struct {
+	SecretToken string // Secret token the webhook requests must have, random by default: set the same one on every instance serving the webhooks
+	mutex       sync.RWMutex
+	bots        map[string]*GoBot
+	handlers    []Handler
+	middlewares []Middleware
+	polling     bool
+	loops       sync.WaitGroup
+	stop        chan struct{}
+	stopOnce    sync.Once
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		SecretToken: newSecretToken(),
+		bots:        map[string]*GoBot{},
+		stop:        make(chan struct{}),
+	}
+}
+
+// newSecretToken returns a random secret token, or an empty one, which refuses every webhook request, if
+// there is no randomness available.
+func newSecretToken() string {
+	token := make([]byte, 32)
+
+	if _, err := rand.Read(token); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(token)
+}
+
+func (manager *Manager) AddHandler(updateType interface{}, callback func(bot *GoBot, update *Update)) {
+	handler := newHandler(updateType, callback)
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	manager.handlers = append(manager.handlers, handler)
+}
+
+func (manager *Manager) Use(middlewares ...Middleware) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	manager.middlewares = append(manager.middlewares, middlewares...)
+}
+
+// shared returns the middlewares and the handlers of the manager, none if the bot was removed.
+func (manager *Manager) shared(bot *GoBot) ([]Middleware, []Handler) {
+	manager.mutex.RLock()
+	defer manager.mutex.RUnlock()
+
+	if managed, ok := manager.bots[bot.name]; !ok || managed.token != bot.token {
+		return nil, nil
+	}
+	// The middlewares are capped so that appending the ones of the bot copies them
+	return manager.middlewares[:len(manager.middlewares):len(manager.middlewares)], manager.handlers
+}
+
+// Add makes the manager run the bot under the name, starting to poll for its updates if the manager is
+// polling. The bot mustn't be running yet. The name is the path of its webhook, so it can't be empty, "." or
+// "..", nor contain "/" or characters that need escaping in a url. The logs of the bot get it as the "bot" field.
+func (manager *Manager) Add(name string, bot *GoBot) error {
+	if name == "" || name == "." || name == ".." || url.PathEscape(name) != name {
+		return ErrManagerInvalidName
+	}
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if _, ok := manager.bots[name]; ok {
+		return ErrManagerDuplicateBot
+	}
+	bot.manager = manager
+	bot.name = name
+	bot.logger = newNamedLogger(bot.logger, name)
+	manager.bots[name] = bot
+
+	if manager.polling {
+		manager.startLoop(bot)
+	}
+	return nil
+}
+
+// Remove stops the bot and removes it from the manager, returning it if it was there. A removed bot can't
+// be run again.
+func (manager *Manager) Remove(name string) *GoBot {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	bot, ok := manager.bots[name]
+
+	if !ok {
+		return nil
+	}
+	delete(manager.bots, name)
+	bot.Stop()
+
+	if bot.metrics != nil {
+		bot.metrics.UnwatchQueue(bot)
+	}
+	return bot
+}
+
+func (manager *Manager) Bot(name string) *GoBot {
+	manager.mutex.RLock()
+	defer manager.mutex.RUnlock()
+	return manager.bots[name]
+}
+
+// Names returns the names of the bots, sorted.
+func (manager *Manager) Names() []string {
+	manager.mutex.RLock()
+	defer manager.mutex.RUnlock()
+	names := make([]string, 0, len(manager.bots))
+
+	for name := range manager.bots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Name returns the name of the bot in its manager, empty if it isn't managed.
+func (bot *GoBot) Name() string {
+	return bot.name
+}
+
+// Poll runs the loop of every bot, including the ones added later, until Stop is called.
+func (manager *Manager) Poll() {
+	manager.mutex.Lock()
+	manager.polling = true
+
+	for _, bot := range manager.bots {
+		manager.startLoop(bot)
+	}
+	manager.mutex.Unlock()
+	<-manager.stop
+	manager.loops.Wait()
+}
+
+func (manager *Manager) startLoop(bot *GoBot) {
+	manager.loops.Add(1)
+
+	go func() {
+		defer manager.loops.Done()
+		_ = bot.Loop(false)
+	}()
+}
+
+// Stop stops the bots and makes Poll return once their loops end.
+func (manager *Manager) Stop() {
+	manager.stopOnce.Do(func() {
+		manager.mutex.Lock()
+		defer manager.mutex.Unlock()
+		manager.polling = false
+
+		for _, bot := range manager.bots {
+			bot.Stop()
+		}
+		close(manager.stop)
+	})
+}
+
+// SetWebhooks sets the webhook of every bot to baseUrl followed by its name, e.g.
+// "https://example.com/bots/" for the server of the manager mounted on /bots/, with the SecretToken of the
+// manager. The names can be guessed, so the token is what keeps others from sending fake updates.
+func (manager *Manager) SetWebhooks(baseUrl string) error {
+	if manager.SecretToken == "" {
+		return ErrManagerNoSecretToken
+	}
+
+	for _, name := range manager.Names() {
+		bot := manager.Bot(name)
+
+		if bot == nil {
+			continue
+		}
+		_, err := bot.SetWebhook(SetWebhookParams{
+			Url:         strings.TrimSuffix(baseUrl, "/") + "/" + name,
+			SecretToken: manager.SecretToken,
+		})
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ServeHTTP receives the updates sent to the webhook of the bot named after the last element of the path.
+// The requests without the SecretToken of the manager are refused, all of them if it's empty.
+func (manager *Manager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	secretToken := r.Header.Get("X-Telegram-Bot-Api-Secret-Token")
+
+	if manager.SecretToken == "" || subtle.ConstantTimeCompare([]byte(secretToken), []byte(manager.SecretToken)) != 1 {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	bot := manager.Bot(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+
+	if bot == nil {
+		http.NotFound(w, r)
+		return
+	}
+	var update Update
+
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	go bot.HandleUpdate(&update)
+}
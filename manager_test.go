@@ -0,0 +1,153 @@
+package gobot
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestManagerWebhookSecretToken(t *testing.T) {
+	manager := NewManager()
+
+	if len(manager.SecretToken) != 64 || NewManager().SecretToken == manager.SecretToken {
+		t.Fatalf("expected a random secret token, got %q", manager.SecretToken)
+	}
+	bot := Init("123:token")
+	bot.SetLogger(nil)
+	handled := make(chan int, 1)
+	bot.AddHandler(&Message{}, func(bot *GoBot, update *Update) {
+		handled <- update.UpdateId
+	})
+
+	if err := manager.Add("bot", bot); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		secretToken string
+		path        string
+		status      int
+	}{
+		{"no token", "", "/bot", http.StatusForbidden},
+		{"wrong token", "wrong", "/bot", http.StatusForbidden},
+		{"unknown bot", manager.SecretToken, "/other", http.StatusNotFound},
+		{"right token", manager.SecretToken, "/bot", http.StatusOK},
+	}
+
+	for _, test := range tests {
+		request := httptest.NewRequest(http.MethodPost, test.path, strings.NewReader(`{"update_id":7,"message":{"message_id":1}}`))
+
+		if test.secretToken != "" {
+			request.Header.Set("X-Telegram-Bot-Api-Secret-Token", test.secretToken)
+		}
+		recorder := httptest.NewRecorder()
+		manager.ServeHTTP(recorder, request)
+
+		if recorder.Code != test.status {
+			t.Errorf("%s: expected the status %d, got %d", test.name, test.status, recorder.Code)
+		}
+	}
+
+	select {
+	case updateId := <-handled:
+		if updateId != 7 {
+			t.Errorf("expected the update 7, got %d", updateId)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the update with the right token to be handled")
+	}
+
+	if len(handled) != 0 {
+		t.Error("expected only the update with the right token to be handled")
+	}
+}
+
+func TestManagerWithoutSecretToken(t *testing.T) {
+	manager := NewManager()
+	manager.SecretToken = ""
+	bot := Init("123:token")
+	bot.SetLogger(nil)
+	bot.SetTransport(TransportFunc(func(method string, params interface{}) (json.RawMessage, error) {
+		t.Errorf("expected no webhook to be set, got %s", method)
+		return json.RawMessage("true"), nil
+	}))
+
+	if err := manager.Add("bot", bot); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := manager.SetWebhooks("https://example.com/"); !errors.Is(err, ErrManagerNoSecretToken) {
+		t.Errorf("expected ErrManagerNoSecretToken, got %v", err)
+	}
+	request := httptest.NewRequest(http.MethodPost, "/bot", strings.NewReader(`{"update_id":1}`))
+	recorder := httptest.NewRecorder()
+	manager.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("expected the request to be refused, got the status %d", recorder.Code)
+	}
+}
+
+func TestManagerSetWebhooks(t *testing.T) {
+	manager := NewManager()
+	var params []SetWebhookParams
+	bot := Init("123:token")
+	bot.SetLogger(nil)
+	bot.SetTransport(TransportFunc(func(method string, request interface{}) (json.RawMessage, error) {
+		params = append(params, request.(SetWebhookParams))
+		return json.RawMessage("true"), nil
+	}))
+
+	if err := manager.Add("bot", bot); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := manager.SetWebhooks("https://example.com/bots/"); err != nil {
+		t.Fatal(err)
+	} else if len(params) != 1 || params[0].Url != "https://example.com/bots/bot" || params[0].SecretToken != manager.SecretToken {
+		t.Errorf("expected the webhook with the secret token, got %+v", params)
+	}
+}
+
+// watchMetrics records the bots whose queue is watched.
+type watchMetrics struct {
+	watched map[*GoBot]bool
+}
+
+func (metrics *watchMetrics) ObserveRequest(string, string, time.Duration, error) {}
+
+func (metrics *watchMetrics) ObserveRetry(string, string) {}
+
+func (metrics *watchMetrics) ObserveUpdate(string, string, time.Duration, bool) {}
+
+func (metrics *watchMetrics) WatchQueue(bot *GoBot) {
+	metrics.watched[bot] = true
+}
+
+func (metrics *watchMetrics) UnwatchQueue(bot *GoBot) {
+	delete(metrics.watched, bot)
+}
+
+func TestManagerRemoveUnwatchesQueue(t *testing.T) {
+	manager := NewManager()
+	metrics := &watchMetrics{watched: map[*GoBot]bool{}}
+	bot := Init("123:token")
+	bot.SetLogger(nil)
+	bot.SetMetrics(metrics)
+
+	if err := manager.Add("bot", bot); err != nil {
+		t.Fatal(err)
+	} else if !metrics.watched[bot] {
+		t.Fatal("expected the queue of the bot to be watched")
+	}
+
+	if manager.Remove("bot") != bot {
+		t.Fatal("expected the removed bot")
+	} else if len(metrics.watched) != 0 {
+		t.Error("expected the queue of the removed bot not to be watched anymore")
+	}
+}
@@ -0,0 +1,124 @@
+package gobot
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MediaGroup is an album, whose items arrive as separate messages sharing the same MediaGroupId.
+type MediaGroup struct {
+	Id              string
+	Chat            *Chat
+	Messages        []*Message       // Messages of the album, sorted by MessageId
+	Caption         string           // Caption of the album, taken from whichever message carries it
+	CaptionEntities []*MessageEntity // Entities of the caption
+}
+
+type mediaGroupAggregator struct {
+	mutex    sync.Mutex
+	window   time.Duration
+	callback func(bot *GoBot, group *MediaGroup)
+	pending  map[string]*pendingMediaGroup
+}
+
+type pendingMediaGroup struct {
+	group    *MediaGroup
+	update   *Update // First update of the group, for the logs and the metrics
+	timer    Timer
+	deadline time.Time
+}
+
+// OnMediaGroup buffers the messages and channel posts that belong to a media group and, once no new item
+// has arrived for the given window, calls the callback once with the whole group. Such messages are no
+// longer dispatched to the other handlers. A panic of the callback is recovered and logged.
+func (bot *GoBot) OnMediaGroup(window time.Duration, callback func(bot *GoBot, group *MediaGroup)) {
+	aggregator := &mediaGroupAggregator{
+		window:   window,
+		callback: callback,
+		pending:  map[string]*pendingMediaGroup{},
+	}
+	bot.mutex.Lock()
+	defer bot.mutex.Unlock()
+	bot.mediaGroups = aggregator
+}
+
+// add buffers the message of the update, if it belongs to a media group, and reports whether it did.
+func (aggregator *mediaGroupAggregator) add(bot *GoBot, update *Update) bool {
+	message := update.Message
+
+	if message == nil {
+		message = update.ChannelPost
+	}
+
+	if message == nil || message.MediaGroupId == "" {
+		return false
+	}
+	key := message.MediaGroupId
+
+	if message.Chat != nil {
+		key = strconv.Itoa(message.Chat.Id) + ":" + key
+	}
+	aggregator.mutex.Lock()
+	defer aggregator.mutex.Unlock()
+	pending, ok := aggregator.pending[key]
+
+	if !ok {
+		pending = &pendingMediaGroup{
+			group:    &MediaGroup{Id: message.MediaGroupId, Chat: message.Chat},
+			update:   update,
+			deadline: bot.clock.Now().Add(aggregator.window),
+		}
+		pending.timer = bot.clock.AfterFunc(aggregator.window, func() {
+			aggregator.flush(bot, key)
+		})
+		aggregator.pending[key] = pending
+	} else {
+		pending.deadline = bot.clock.Now().Add(aggregator.window)
+		pending.timer.Reset(aggregator.window)
+	}
+	pending.group.Messages = append(pending.group.Messages, message)
+	// The item stays in the queue until the group is flushed
+	bot.addQueueDepth(1)
+
+	if message.Caption != "" && pending.group.Caption == "" {
+		pending.group.Caption = message.Caption
+		pending.group.CaptionEntities = message.CaptionEntities
+	}
+	return true
+}
+
+func (aggregator *mediaGroupAggregator) flush(bot *GoBot, key string) {
+	aggregator.mutex.Lock()
+	pending, ok := aggregator.pending[key]
+
+	if !ok || bot.clock.Now().Before(pending.deadline) {
+		// The timer fired while a new item reset it, so it will fire again
+		aggregator.mutex.Unlock()
+		return
+	}
+	delete(aggregator.pending, key)
+	aggregator.mutex.Unlock()
+	messages := pending.group.Messages
+	defer bot.addQueueDepth(-int64(len(messages)))
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].MessageId < messages[j].MessageId
+	})
+	// The span of the update that started the group has ended, so the group gets a span of its own
+	bot = bot.WithContext(context.Background())
+	ctx, span := bot.startSpan("gobot.HandleMediaGroup")
+	defer span.End()
+	span.SetAttribute("telegram.update_id", pending.update.UpdateId)
+	span.SetAttribute("telegram.media_group_id", pending.group.Id)
+
+	if bot.manager != nil {
+		span.SetAttribute("telegram.bot", bot.name)
+	}
+	groupBot := bot.WithContext(ctx)
+	groupBot.update = pending.update
+	buffered := false
+	defer groupBot.recoverUpdate(pending.update, time.Now(), span, &buffered)
+	aggregator.callback(groupBot, pending.group)
+}
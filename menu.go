@@ -0,0 +1,409 @@
+package gobot
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+)
+
+var ErrMenuNoChat = errors.New("update has no chat to send the menu to")
+
+const menuMaxStacks = 10000
+
+// MenuAction is called when the button of an action is pressed. The callback query is answered after the
+// action returns, unless the action already answered it with MenuSystem.Answer.
+type MenuAction func(bot *GoBot, update *Update)
+
+// DynamicButton is a button computed when a menu is rendered. It opens Submenu if set, else Url if set,
+// else it calls the action of the dynamic buttons with Value.
+type DynamicButton struct {
+	Text    string
+	Url     string
+	Submenu *Menu
+	Value   string
+}
+
+type menuItem struct {
+	text    string
+	url     string
+	submenu *Menu
+	action  MenuAction
+	dynamic func(bot *GoBot, update *Update) []*DynamicButton
+	handler func(bot *GoBot, update *Update, value string)
+	newRow  bool
+}
+
+// Menu is a page of an inline menu, with a title and buttons that open submenus, urls or run actions.
+// Its id must be unique among the menus of a MenuSystem.
+type Menu struct {
+	Id        string
+	Title     string                                  // Text of the message, formatted as HTML
+	TitleFunc func(bot *GoBot, update *Update) string // Optional. Computes the title when the menu is rendered
+	Columns   int                                     // Number of buttons per row, defaults to 1
+	items     []*menuItem
+}
+
+func NewMenu(id string, title string) *Menu {
+	return &Menu{
+		Id:      id,
+		Title:   title,
+		Columns: 1,
+	}
+}
+
+func (menu *Menu) Submenu(text string, submenu *Menu) *Menu {
+	menu.items = append(menu.items, &menuItem{text: text, submenu: submenu})
+	return menu
+}
+
+func (menu *Menu) Url(text string, url string) *Menu {
+	menu.items = append(menu.items, &menuItem{text: text, url: url})
+	return menu
+}
+
+func (menu *Menu) Action(text string, action MenuAction) *Menu {
+	menu.items = append(menu.items, &menuItem{text: text, action: action})
+	return menu
+}
+
+// Dynamic adds the buttons returned by build, computed every time the menu is rendered. Pressing one of them
+// that has no Submenu or Url calls handler with its Value, whose callback query is answered as for a MenuAction.
+func (menu *Menu) Dynamic(build func(bot *GoBot, update *Update) []*DynamicButton, handler func(bot *GoBot, update *Update, value string)) *Menu {
+	menu.items = append(menu.items, &menuItem{dynamic: build, handler: handler})
+	return menu
+}
+
+// Row makes the next button start a new row.
+func (menu *Menu) Row() *Menu {
+	menu.items = append(menu.items, &menuItem{newRow: true})
+	return menu
+}
+
+// MenuSystem shows a tree of menus in a single message, edited in place as the user navigates, and
+// remembers for the last 10000 users and messages the menus they came from so that they can go back.
+type MenuSystem struct {
+	prefix    string
+	root      *Menu
+	BackText  string
+	OnError   func(update *Update, err error) // Optional. Called when a menu can't be shown
+	mutex     sync.Mutex
+	menus     map[string]*Menu
+	stacks    map[string][]string
+	stackKeys []string // Keys of stacks in insertion order, to evict the oldest one
+	nextKey   int
+	answered  map[string]bool
+}
+
+type menuData struct {
+	Menu    string
+	Item    int
+	Value   string
+	Submenu string
+	Back    bool
+}
+
+// NewMenuSystem creates a menu system with the given root menu and registers its callback handler with the given prefix.
+// Its callback data is signed only if the CallbackCodec of the bot has a Secret, otherwise a user can forge it
+// to open any menu or press any button, even one that isn't shown to them.
+func NewMenuSystem(bot *GoBot, prefix string, root *Menu) *MenuSystem {
+	system := &MenuSystem{
+		prefix:    prefix,
+		root:      root,
+		BackText:  "« Back",
+		menus:     map[string]*Menu{},
+		stacks:    map[string][]string{},
+		stackKeys: make([]string, menuMaxStacks),
+		answered:  map[string]bool{},
+	}
+	system.register(root)
+	bot.OnCallback(prefix, system.handle)
+	return system
+}
+
+func (system *MenuSystem) register(menu *Menu) {
+	system.mutex.Lock()
+	_, ok := system.menus[menu.Id]
+	system.menus[menu.Id] = menu
+	system.mutex.Unlock()
+
+	if ok {
+		return
+	}
+
+	for _, item := range menu.items {
+		if item.submenu != nil {
+			system.register(item.submenu)
+		}
+	}
+}
+
+func (system *MenuSystem) menu(id string) *Menu {
+	system.mutex.Lock()
+	defer system.mutex.Unlock()
+	return system.menus[id]
+}
+
+// Render returns the title and the keyboard of the menu for the given update.
+func (system *MenuSystem) Render(bot *GoBot, update *Update, menu *Menu, back bool) (string, *InlineKeyboardMarkup, error) {
+	system.register(menu)
+	var rows [][]*InlineKeyboardButton
+	var row []*InlineKeyboardButton
+	add := func(button *InlineKeyboardButton) {
+		if menu.Columns > 0 && len(row) >= menu.Columns {
+			rows = append(rows, row)
+			row = nil
+		}
+		row = append(row, button)
+	}
+
+	for i, item := range menu.items {
+		switch {
+		case item.newRow:
+			if len(row) > 0 {
+				rows = append(rows, row)
+				row = nil
+			}
+		case item.url != "":
+			add(NewInlineKeyboardButton(item.text, item.url, false))
+		case item.dynamic != nil:
+			for _, button := range item.dynamic(bot, update) {
+				if button.Url != "" && button.Submenu == nil {
+					add(NewInlineKeyboardButton(button.Text, button.Url, false))
+					continue
+				}
+				data := menuData{Menu: menu.Id, Item: i, Value: button.Value}
+
+				if button.Submenu != nil {
+					system.register(button.Submenu)
+					data.Submenu = button.Submenu.Id
+				}
+				callbackData, err := bot.CallbackData(system.prefix, data)
+
+				if err != nil {
+					return "", nil, err
+				}
+				add(NewInlineKeyboardButton(button.Text, callbackData, true))
+			}
+		default:
+			callbackData, err := bot.CallbackData(system.prefix, menuData{Menu: menu.Id, Item: i})
+
+			if err != nil {
+				return "", nil, err
+			}
+			add(NewInlineKeyboardButton(item.text, callbackData, true))
+		}
+	}
+
+	if len(row) > 0 {
+		rows = append(rows, row)
+	}
+
+	if back {
+		callbackData, err := bot.CallbackData(system.prefix, menuData{Menu: menu.Id, Back: true})
+
+		if err != nil {
+			return "", nil, err
+		}
+		rows = append(rows, NewInlineKeyboardRow(NewInlineKeyboardButton(system.BackText, callbackData, true)))
+	}
+	title := menu.Title
+
+	if menu.TitleFunc != nil {
+		title = menu.TitleFunc(bot, update)
+	}
+	return title, NewInlineKeyboardMarkup(rows...), nil
+}
+
+// Send sends the root menu to the chat of the update, which can be a message or a callback query.
+func (system *MenuSystem) Send(bot *GoBot, update *Update) (*Message, error) {
+	var chat *Chat
+
+	if update.Message != nil {
+		chat = update.Message.Chat
+	} else if update.CallbackQuery != nil && update.CallbackQuery.Message != nil {
+		chat = update.CallbackQuery.Message.Chat
+	}
+
+	if chat == nil {
+		return nil, ErrMenuNoChat
+	}
+	title, keyboard, err := system.Render(bot, update, system.root, false)
+
+	if err != nil {
+		return nil, err
+	}
+	return bot.SendMessage(NewSendMessage(chat.Id, title, keyboard))
+}
+
+// Show edits the message of the callback query to display the menu, remembering the current one so that
+// the user can go back to it.
+func (system *MenuSystem) Show(bot *GoBot, update *Update, menu *Menu) error {
+	return system.show(bot, update, "", menu)
+}
+
+// show opens the menu from the menu with id from, or from the current one if from is empty.
+func (system *MenuSystem) show(bot *GoBot, update *Update, from string, menu *Menu) error {
+	key := menuStackKey(update.CallbackQuery)
+	system.mutex.Lock()
+	stack := system.stacks[key]
+
+	if len(stack) == 0 || from != "" && stack[len(stack)-1] != from {
+		// The history was lost, e.g. after a restart, so the user goes back to the root
+		stack = []string{system.root.Id}
+
+		if from != "" && from != system.root.Id {
+			stack = append(stack, from)
+		}
+	}
+
+	if stack[len(stack)-1] != menu.Id {
+		stack = append(stack, menu.Id)
+	}
+	system.setStack(key, stack)
+	system.mutex.Unlock()
+	return system.edit(bot, update, menu, len(stack) > 1)
+}
+
+// Refresh renders again the menu the user is currently in, e.g. after an action changed its dynamic buttons.
+func (system *MenuSystem) Refresh(bot *GoBot, update *Update) error {
+	system.mutex.Lock()
+	stack := system.stacks[menuStackKey(update.CallbackQuery)]
+	system.mutex.Unlock()
+	menu := system.root
+
+	if len(stack) > 0 {
+		if current := system.menu(stack[len(stack)-1]); current != nil {
+			menu = current
+		}
+	}
+	return system.edit(bot, update, menu, len(stack) > 1)
+}
+
+func (system *MenuSystem) back(bot *GoBot, update *Update) error {
+	key := menuStackKey(update.CallbackQuery)
+	system.mutex.Lock()
+	stack := system.stacks[key]
+
+	if len(stack) > 1 {
+		stack = stack[:len(stack)-1]
+	}
+
+	if len(stack) > 1 {
+		system.setStack(key, stack)
+	} else {
+		delete(system.stacks, key)
+	}
+	system.mutex.Unlock()
+	menu := system.root
+
+	if len(stack) > 0 {
+		if previous := system.menu(stack[len(stack)-1]); previous != nil {
+			menu = previous
+		}
+	}
+	return system.edit(bot, update, menu, len(stack) > 1)
+}
+
+// setStack stores the stack of the key, evicting the oldest one if there are too many. It must be called
+// with the mutex locked.
+func (system *MenuSystem) setStack(key string, stack []string) {
+	if _, ok := system.stacks[key]; !ok {
+		if oldKey := system.stackKeys[system.nextKey]; oldKey != "" {
+			delete(system.stacks, oldKey)
+		}
+		system.stackKeys[system.nextKey] = key
+		system.nextKey = (system.nextKey + 1) % len(system.stackKeys)
+	}
+	system.stacks[key] = stack
+}
+
+func (system *MenuSystem) edit(bot *GoBot, update *Update, menu *Menu, back bool) error {
+	query := update.CallbackQuery
+	title, keyboard, err := system.Render(bot, update, menu, back)
+
+	if err != nil {
+		return err
+	}
+	params := EditMessageTextParams{
+		InlineMessageId: query.InlineMessageId,
+		Text:            title,
+		ParseMode:       "HTML",
+		ReplyMarkup:     keyboard,
+	}
+
+	if query.Message != nil {
+		params.ChatId = NewChatId(query.Message.Chat.Id)
+		params.MessageId = query.Message.MessageId
+	}
+	_, err = bot.EditMessageText(params)
+	return err
+}
+
+func menuStackKey(query *CallbackQuery) string {
+	if query.Message != nil {
+		return strconv.Itoa(query.From.Id) + ":" + strconv.Itoa(query.Message.Chat.Id) + ":" + strconv.Itoa(query.Message.MessageId)
+	}
+	return strconv.Itoa(query.From.Id) + ":" + query.InlineMessageId
+}
+
+// Answer answers the callback query of the update, e.g. to show an alert from an action, so that the menu
+// system doesn't answer it again.
+func (system *MenuSystem) Answer(bot *GoBot, update *Update, text string, showAlert bool) error {
+	query := update.CallbackQuery
+	system.mutex.Lock()
+	answered, handling := system.answered[query.Id]
+
+	if answered {
+		system.mutex.Unlock()
+		return nil
+	} else if handling {
+		system.answered[query.Id] = true
+	}
+	system.mutex.Unlock()
+	_, err := bot.AnswerCallbackQuery(query.NewAnswerCallbackQuery(text, showAlert))
+	return err
+}
+
+func (system *MenuSystem) handle(bot *GoBot, update *Update, data *menuData) {
+	query := update.CallbackQuery
+	system.mutex.Lock()
+	system.answered[query.Id] = false
+	system.mutex.Unlock()
+	defer func() {
+		system.mutex.Lock()
+		answered := system.answered[query.Id]
+		delete(system.answered, query.Id)
+		system.mutex.Unlock()
+
+		if !answered {
+			_, _ = bot.AnswerCallbackQuery(query.NewAnswerCallbackQuery("", false))
+		}
+	}()
+	menu := system.menu(data.Menu)
+	var err error
+
+	if data.Back {
+		err = system.back(bot, update)
+	} else if menu != nil && data.Item >= 0 && data.Item < len(menu.items) {
+		item := menu.items[data.Item]
+
+		switch {
+		case item.submenu != nil:
+			err = system.show(bot, update, menu.Id, item.submenu)
+		case item.action != nil:
+			item.action(bot, update)
+		case item.dynamic != nil:
+			if data.Submenu != "" {
+				if submenu := system.menu(data.Submenu); submenu != nil {
+					err = system.show(bot, update, menu.Id, submenu)
+				}
+			} else if item.handler != nil {
+				item.handler(bot, update, data.Value)
+			}
+		}
+	}
+
+	if err != nil && system.OnError != nil {
+		system.OnError(update, err)
+	}
+}
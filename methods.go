@@ -1,12 +1,14 @@
+// Code generated by gobot-gen from Bot API 7.10. DO NOT EDIT.
+
 package gobot
 
 import "encoding/json"
 
 type GetUpdatesParams struct {
-	Offset         int      `json:"offset,omitempty"`          // Optional. Identifier of the first update to be returned. Must be greater by one than the highest among the identifiers of previously received updates. By default, updates starting with the earliest unconfirmed update are returned. An update is considered confirmed as soon as getUpdates is called with an offset higher than its update_id. The negative offset can be specified to retrieve updates starting from -offset update from the end of the updates queue. All previous updates will forgotten.
-	Limit          int      `json:"limit,omitempty"`           // Optional. Limits the number of updates to be retrieved. Values between 1-100 are accepted. Defaults to 100.
-	Timeout        int      `json:"timeout,omitempty"`         // Optional. Timeout in seconds for long polling. Defaults to 0, i.e. usual short polling. Should be positive, short polling should be used for testing purposes only.
-	AllowedUpdates []string `json:"allowed_updates,omitempty"` // Optional. A JSON-serialized list of the update types you want your bot to receive. For example, specify ["message", "edited_channel_post", "callback_query"] to only receive updates of these types. See Update for a complete list of available update types. Specify an empty list to receive all update types except chat_member (default). If not specified, the previous setting will be used.Please note that this parameter doesn't affect updates created before the call to the getUpdates, so unwanted updates may be received for a short period of time.
+	Offset         int      `json:"offset,omitempty"`                       // Optional. Identifier of the first update to be returned. Must be greater by one than the highest among the identifiers of previously received updates. By default, updates starting with the earliest unconfirmed update are returned. An update is considered confirmed as soon as getUpdates is called with an offset higher than its update_id. The negative offset can be specified to retrieve updates starting from -offset update from the end of the updates queue. All previous updates will forgotten.
+	Limit          int      `json:"limit,omitempty" validate:"range=1-100"` // Optional. Limits the number of updates to be retrieved. Values between 1-100 are accepted. Defaults to 100.
+	Timeout        int      `json:"timeout,omitempty"`                      // Optional. Timeout in seconds for long polling. Defaults to 0, i.e. usual short polling. Should be positive, short polling should be used for testing purposes only.
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`              // Optional. A JSON-serialized list of the update types you want your bot to receive. For example, specify ["message", "edited_channel_post", "callback_query"] to only receive updates of these types. See Update for a complete list of available update types. Specify an empty list to receive all update types except chat_member (default). If not specified, the previous setting will be used.Please note that this parameter doesn't affect updates created before the call to the getUpdates, so unwanted updates may be received for a short period of time.
 }
 
 func (bot GoBot) GetUpdates(params GetUpdatesParams) ([]*Update, error) {
@@ -20,12 +22,13 @@ func (bot GoBot) GetUpdates(params GetUpdatesParams) ([]*Update, error) {
 }
 
 type SetWebhookParams struct {
-	Url                string      `json:"url"`                            // HTTPS url to send updates to. Use an empty string to remove webhook integration
-	Certificate        interface{} `json:"certificate,omitempty"`          // Optional. Upload your public key certificate so that the root certificate in use can be checked. See our self-signed guide for details.
-	IpAddress          string      `json:"ip_address,omitempty"`           // Optional. The fixed IP address which will be used to send webhook requests instead of the IP address resolved through DNS
-	MaxConnections     int         `json:"max_connections,omitempty"`      // Optional. Maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery, 1-100. Defaults to 40. Use lower values to limit the load on your bot's server, and higher values to increase your bot's throughput.
-	AllowedUpdates     []string    `json:"allowed_updates,omitempty"`      // Optional. A JSON-serialized list of the update types you want your bot to receive. For example, specify ["message", "edited_channel_post", "callback_query"] to only receive updates of these types. See Update for a complete list of available update types. Specify an empty list to receive all update types except chat_member (default). If not specified, the previous setting will be used.Please note that this parameter doesn't affect updates created before the call to the setWebhook, so unwanted updates may be received for a short period of time.
-	DropPendingUpdates bool        `json:"drop_pending_updates,omitempty"` // Optional. Pass True to drop all pending updates
+	Url                string      `json:"url"`                                              // HTTPS url to send updates to. Use an empty string to remove webhook integration
+	Certificate        interface{} `json:"certificate,omitempty"`                            // Optional. Upload your public key certificate so that the root certificate in use can be checked. See our self-signed guide for details.
+	IpAddress          string      `json:"ip_address,omitempty"`                             // Optional. The fixed IP address which will be used to send webhook requests instead of the IP address resolved through DNS
+	MaxConnections     int         `json:"max_connections,omitempty" validate:"range=1-100"` // Optional. Maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery, 1-100. Defaults to 40. Use lower values to limit the load on your bot's server, and higher values to increase your bot's throughput.
+	AllowedUpdates     []string    `json:"allowed_updates,omitempty"`                        // Optional. A JSON-serialized list of the update types you want your bot to receive. For example, specify ["message", "edited_channel_post", "callback_query"] to only receive updates of these types. See Update for a complete list of available update types. Specify an empty list to receive all update types except chat_member (default). If not specified, the previous setting will be used.Please note that this parameter doesn't affect updates created before the call to the setWebhook, so unwanted updates may be received for a short period of time.
+	DropPendingUpdates bool        `json:"drop_pending_updates,omitempty"`                   // Optional. Pass True to drop all pending updates
+	SecretToken        string      `json:"secret_token,omitempty" validate:"len=1-256"`      // Optional. A secret token to be sent in a header "X-Telegram-Bot-Api-Secret-Token" in every webhook request, 1-256 characters. Only characters A-Z, a-z, 0-9, _ and - are allowed. The header is useful to ensure that the request comes from a webhook set by you.
 }
 
 func (bot GoBot) SetWebhook(params SetWebhookParams) (bool, error) {
@@ -89,15 +92,21 @@ func (bot GoBot) Close() (bool, error) {
 }
 
 type SendMessageParams struct {
-	ChatId                   interface{}      `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Text                     string           `json:"text"`                                  // Text of the message to be sent, 1-4096 characters after entities parsing
-	ParseMode                string           `json:"parse_mode,omitempty"`                  // Optional. Mode for parsing entities in the message text. See formatting options for more details.
-	Entities                 []*MessageEntity `json:"entities,omitempty"`                    // Optional. List of special entities that appear in message text, which can be specified instead of parse_mode
-	DisableWebPagePreview    bool             `json:"disable_web_page_preview,omitempty"`    // Optional. Disables link previews for links in this message
-	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{}      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string              `json:"business_connection_id,omitempty"`      // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId             `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int                 `json:"message_thread_id,omitempty"`           // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Text                     string              `json:"text" validate:"len=1-4096"`            // Text of the message to be sent, 1-4096 characters after entities parsing
+	ParseMode                string              `json:"parse_mode,omitempty"`                  // Optional. Mode for parsing entities in the message text. See formatting options for more details.
+	Entities                 []*MessageEntity    `json:"entities,omitempty"`                    // Optional. List of special entities that appear in message text, which can be specified instead of parse_mode
+	LinkPreviewOptions       *LinkPreviewOptions `json:"link_preview_options,omitempty"`        // Optional. Link preview generation options for the message
+	DisableWebPagePreview    bool                `json:"disable_web_page_preview,omitempty"`    // Optional. Deprecated, use LinkPreviewOptions. Disables link previews for links in this message
+	DisableNotification      bool                `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool                `json:"protect_content,omitempty"`             // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string              `json:"message_effect_id,omitempty"`           // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int                 `json:"reply_to_message_id,omitempty"`         // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool                `json:"allow_sending_without_reply,omitempty"` // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters    `json:"reply_parameters,omitempty"`            // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup         `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendMessage(params SendMessageParams) (*Message, error) {
@@ -111,10 +120,12 @@ func (bot GoBot) SendMessage(params SendMessageParams) (*Message, error) {
 }
 
 type ForwardMessageParams struct {
-	ChatId              interface{} `json:"chat_id"`                        // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	FromChatId          interface{} `json:"from_chat_id"`                   // Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
-	DisableNotification bool        `json:"disable_notification,omitempty"` // Optional. Sends the message silently. Users will receive a notification with no sound.
-	MessageId           int         `json:"message_id"`                     // Message identifier in the chat specified in from_chat_id
+	ChatId              *ChatId `json:"chat_id"`                        // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId     int     `json:"message_thread_id,omitempty"`    // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	FromChatId          *ChatId `json:"from_chat_id"`                   // Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
+	DisableNotification bool    `json:"disable_notification,omitempty"` // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent      bool    `json:"protect_content,omitempty"`      // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageId           int     `json:"message_id"`                     // Message identifier in the chat specified in from_chat_id
 }
 
 func (bot GoBot) ForwardMessage(params ForwardMessageParams) (*Message, error) {
@@ -127,17 +138,40 @@ func (bot GoBot) ForwardMessage(params ForwardMessageParams) (*Message, error) {
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
+type ForwardMessagesParams struct {
+	ChatId              *ChatId `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId     int     `json:"message_thread_id,omitempty"`      // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	FromChatId          *ChatId `json:"from_chat_id"`                     // Unique identifier for the chat where the original messages were sent (or channel username in the format @channelusername)
+	MessageIds          []int   `json:"message_ids" validate:"len=1-100"` // A JSON-serialized list of 1-100 identifiers of messages in the chat from_chat_id to forward. The identifiers must be specified in a strictly increasing order.
+	DisableNotification bool    `json:"disable_notification,omitempty"`   // Optional. Sends the messages silently. Users will receive a notification with no sound.
+	ProtectContent      bool    `json:"protect_content,omitempty"`        // Optional. Protects the contents of the forwarded messages from forwarding and saving
+}
+
+func (bot GoBot) ForwardMessages(params ForwardMessagesParams) ([]*MessageId, error) {
+	response, err := bot.Request("forwardMessages", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse []*MessageId
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type CopyMessageParams struct {
-	ChatId                   interface{}      `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	FromChatId               interface{}      `json:"from_chat_id"`                          // Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
-	MessageId                int              `json:"message_id"`                            // Message identifier in the chat specified in from_chat_id
-	Caption                  string           `json:"caption,omitempty"`                     // Optional. New caption for media, 0-1024 characters after entities parsing. If not specified, the original caption is kept
-	ParseMode                string           `json:"parse_mode,omitempty"`                  // Optional. Mode for parsing entities in the new caption. See formatting options for more details.
-	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`            // Optional. List of special entities that appear in the new caption, which can be specified instead of parse_mode
-	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{}      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ChatId                   *ChatId          `json:"chat_id"`                                 // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`             // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	FromChatId               *ChatId          `json:"from_chat_id"`                            // Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
+	MessageId                int              `json:"message_id"`                              // Message identifier in the chat specified in from_chat_id
+	Caption                  string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. New caption for media, 0-1024 characters after entities parsing. If not specified, the original caption is kept
+	ParseMode                string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the new caption. See formatting options for more details.
+	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the new caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia    bool             `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media. Ignored if a new caption isn't specified.
+	DisableNotification      bool             `json:"disable_notification,omitempty"`          // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`               // Optional. Protects the contents of the sent message from forwarding and saving
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`           // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`   // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`              // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                  // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) CopyMessage(params CopyMessageParams) (*MessageId, error) {
@@ -150,16 +184,43 @@ func (bot GoBot) CopyMessage(params CopyMessageParams) (*MessageId, error) {
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
+type CopyMessagesParams struct {
+	ChatId              *ChatId `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId     int     `json:"message_thread_id,omitempty"`      // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	FromChatId          *ChatId `json:"from_chat_id"`                     // Unique identifier for the chat where the original messages were sent (or channel username in the format @channelusername)
+	MessageIds          []int   `json:"message_ids" validate:"len=1-100"` // A JSON-serialized list of 1-100 identifiers of messages in the chat from_chat_id to copy. The identifiers must be specified in a strictly increasing order.
+	DisableNotification bool    `json:"disable_notification,omitempty"`   // Optional. Sends the messages silently. Users will receive a notification with no sound.
+	ProtectContent      bool    `json:"protect_content,omitempty"`        // Optional. Protects the contents of the sent messages from forwarding and saving
+	RemoveCaption       bool    `json:"remove_caption,omitempty"`         // Optional. Pass True to copy the messages without their captions
+}
+
+func (bot GoBot) CopyMessages(params CopyMessagesParams) ([]*MessageId, error) {
+	response, err := bot.Request("copyMessages", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse []*MessageId
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type SendPhotoParams struct {
-	ChatId                   interface{}      `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Photo                    interface{}      `json:"photo"`                                 // Photo to send. Pass a file_id as String to send a photo that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a photo from the Internet, or upload a new photo using multipart/form-data. The photo must be at most 10 MB in size. The photo's width and height must not exceed 10000 in total. Width and height ratio must be at most 20. More info on Sending Files »
-	Caption                  string           `json:"caption,omitempty"`                     // Optional. Photo caption (may also be used when resending photos by file_id), 0-1024 characters after entities parsing
-	ParseMode                string           `json:"parse_mode,omitempty"`                  // Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
-	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`            // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{}      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`        // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                                 // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`             // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Photo                    interface{}      `json:"photo"`                                   // Photo to send. Pass a file_id as String to send a photo that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a photo from the Internet, or upload a new photo using multipart/form-data. The photo must be at most 10 MB in size. The photo's width and height must not exceed 10000 in total. Width and height ratio must be at most 20. More info on Sending Files »
+	Caption                  string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Photo caption (may also be used when resending photos by file_id), 0-1024 characters after entities parsing
+	ParseMode                string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
+	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia    bool             `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	HasSpoiler               bool             `json:"has_spoiler,omitempty"`                   // Optional. Pass True if the photo needs to be covered with a spoiler animation
+	DisableNotification      bool             `json:"disable_notification,omitempty"`          // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`               // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`             // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`           // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`   // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`              // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                  // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendPhoto(params SendPhotoParams) (*Message, error) {
@@ -173,19 +234,24 @@ func (bot GoBot) SendPhoto(params SendPhotoParams) (*Message, error) {
 }
 
 type SendAudioParams struct {
-	ChatId                   interface{}      `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Audio                    interface{}      `json:"audio"`                                 // Audio file to send. Pass a file_id as String to send an audio file that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get an audio file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
-	Caption                  string           `json:"caption,omitempty"`                     // Optional. Audio caption, 0-1024 characters after entities parsing
-	ParseMode                string           `json:"parse_mode,omitempty"`                  // Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
-	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`            // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	Duration                 int              `json:"duration,omitempty"`                    // Optional. Duration of the audio in seconds
-	Performer                string           `json:"performer,omitempty"`                   // Optional. Performer
-	Title                    string           `json:"title,omitempty"`                       // Optional. Track name
-	Thumb                    interface{}      `json:"thumb,omitempty"`                       // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{}      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`        // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                                 // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`             // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Audio                    interface{}      `json:"audio"`                                   // Audio file to send. Pass a file_id as String to send an audio file that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get an audio file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
+	Caption                  string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Audio caption, 0-1024 characters after entities parsing
+	ParseMode                string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
+	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	Duration                 int              `json:"duration,omitempty"`                      // Optional. Duration of the audio in seconds
+	Performer                string           `json:"performer,omitempty"`                     // Optional. Performer
+	Title                    string           `json:"title,omitempty"`                         // Optional. Track name
+	Thumbnail                interface{}      `json:"thumbnail,omitempty"`                     // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	DisableNotification      bool             `json:"disable_notification,omitempty"`          // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`               // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`             // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`           // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`   // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`              // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                  // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendAudio(params SendAudioParams) (*Message, error) {
@@ -199,17 +265,22 @@ func (bot GoBot) SendAudio(params SendAudioParams) (*Message, error) {
 }
 
 type SendDocumentParams struct {
-	ChatId                      interface{}      `json:"chat_id"`                                  // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	BusinessConnectionId        string           `json:"business_connection_id,omitempty"`         // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                      *ChatId          `json:"chat_id"`                                  // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId             int              `json:"message_thread_id,omitempty"`              // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	Document                    interface{}      `json:"document"`                                 // File to send. Pass a file_id as String to send a file that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
-	Thumb                       interface{}      `json:"thumb,omitempty"`                          // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	Caption                     string           `json:"caption,omitempty"`                        // Optional. Document caption (may also be used when resending documents by file_id), 0-1024 characters after entities parsing
+	Thumbnail                   interface{}      `json:"thumbnail,omitempty"`                      // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	Caption                     string           `json:"caption,omitempty" validate:"len=0-1024"`  // Optional. Document caption (may also be used when resending documents by file_id), 0-1024 characters after entities parsing
 	ParseMode                   string           `json:"parse_mode,omitempty"`                     // Optional. Mode for parsing entities in the document caption. See formatting options for more details.
 	CaptionEntities             []*MessageEntity `json:"caption_entities,omitempty"`               // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
 	DisableContentTypeDetection bool             `json:"disable_content_type_detection,omitempty"` // Optional. Disables automatic server-side content type detection for files uploaded using multipart/form-data
 	DisableNotification         bool             `json:"disable_notification,omitempty"`           // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId            int              `json:"reply_to_message_id,omitempty"`            // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply    bool             `json:"allow_sending_without_reply,omitempty"`    // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup                 interface{}      `json:"reply_markup,omitempty"`                   // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ProtectContent              bool             `json:"protect_content,omitempty"`                // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId             string           `json:"message_effect_id,omitempty"`              // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId            int              `json:"reply_to_message_id,omitempty"`            // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply    bool             `json:"allow_sending_without_reply,omitempty"`    // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters             *ReplyParameters `json:"reply_parameters,omitempty"`               // Optional. Description of the message to reply to
+	ReplyMarkup                 ReplyMarkup      `json:"reply_markup,omitempty"`                   // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendDocument(params SendDocumentParams) (*Message, error) {
@@ -223,20 +294,27 @@ func (bot GoBot) SendDocument(params SendDocumentParams) (*Message, error) {
 }
 
 type SendVideoParams struct {
-	ChatId                   interface{}      `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Video                    interface{}      `json:"video"`                                 // Video to send. Pass a file_id as String to send a video that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a video from the Internet, or upload a new video using multipart/form-data. More info on Sending Files »
-	Duration                 int              `json:"duration,omitempty"`                    // Optional. Duration of sent video in seconds
-	Width                    int              `json:"width,omitempty"`                       // Optional. Video width
-	Height                   int              `json:"height,omitempty"`                      // Optional. Video height
-	Thumb                    interface{}      `json:"thumb,omitempty"`                       // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	Caption                  string           `json:"caption,omitempty"`                     // Optional. Video caption (may also be used when resending videos by file_id), 0-1024 characters after entities parsing
-	ParseMode                string           `json:"parse_mode,omitempty"`                  // Optional. Mode for parsing entities in the video caption. See formatting options for more details.
-	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`            // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	SupportsStreaming        bool             `json:"supports_streaming,omitempty"`          // Optional. Pass True, if the uploaded video is suitable for streaming
-	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{}      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`        // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                                 // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`             // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Video                    interface{}      `json:"video"`                                   // Video to send. Pass a file_id as String to send a video that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a video from the Internet, or upload a new video using multipart/form-data. More info on Sending Files »
+	Duration                 int              `json:"duration,omitempty"`                      // Optional. Duration of sent video in seconds
+	Width                    int              `json:"width,omitempty"`                         // Optional. Video width
+	Height                   int              `json:"height,omitempty"`                        // Optional. Video height
+	Thumbnail                interface{}      `json:"thumbnail,omitempty"`                     // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	Caption                  string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Video caption (may also be used when resending videos by file_id), 0-1024 characters after entities parsing
+	ParseMode                string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the video caption. See formatting options for more details.
+	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia    bool             `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	HasSpoiler               bool             `json:"has_spoiler,omitempty"`                   // Optional. Pass True if the video needs to be covered with a spoiler animation
+	SupportsStreaming        bool             `json:"supports_streaming,omitempty"`            // Optional. Pass True, if the uploaded video is suitable for streaming
+	DisableNotification      bool             `json:"disable_notification,omitempty"`          // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`               // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`             // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`           // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`   // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`              // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                  // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendVideo(params SendVideoParams) (*Message, error) {
@@ -250,19 +328,26 @@ func (bot GoBot) SendVideo(params SendVideoParams) (*Message, error) {
 }
 
 type SendAnimationParams struct {
-	ChatId                   interface{}      `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Animation                interface{}      `json:"animation"`                             // Animation to send. Pass a file_id as String to send an animation that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get an animation from the Internet, or upload a new animation using multipart/form-data. More info on Sending Files »
-	Duration                 int              `json:"duration,omitempty"`                    // Optional. Duration of sent animation in seconds
-	Width                    int              `json:"width,omitempty"`                       // Optional. Animation width
-	Height                   int              `json:"height,omitempty"`                      // Optional. Animation height
-	Thumb                    interface{}      `json:"thumb,omitempty"`                       // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	Caption                  string           `json:"caption,omitempty"`                     // Optional. Animation caption (may also be used when resending animation by file_id), 0-1024 characters after entities parsing
-	ParseMode                string           `json:"parse_mode,omitempty"`                  // Optional. Mode for parsing entities in the animation caption. See formatting options for more details.
-	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`            // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{}      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`        // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                                 // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`             // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Animation                interface{}      `json:"animation"`                               // Animation to send. Pass a file_id as String to send an animation that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get an animation from the Internet, or upload a new animation using multipart/form-data. More info on Sending Files »
+	Duration                 int              `json:"duration,omitempty"`                      // Optional. Duration of sent animation in seconds
+	Width                    int              `json:"width,omitempty"`                         // Optional. Animation width
+	Height                   int              `json:"height,omitempty"`                        // Optional. Animation height
+	Thumbnail                interface{}      `json:"thumbnail,omitempty"`                     // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	Caption                  string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Animation caption (may also be used when resending animation by file_id), 0-1024 characters after entities parsing
+	ParseMode                string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the animation caption. See formatting options for more details.
+	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia    bool             `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	HasSpoiler               bool             `json:"has_spoiler,omitempty"`                   // Optional. Pass True if the animation needs to be covered with a spoiler animation
+	DisableNotification      bool             `json:"disable_notification,omitempty"`          // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`               // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`             // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`           // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`   // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`              // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                  // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendAnimation(params SendAnimationParams) (*Message, error) {
@@ -276,16 +361,21 @@ func (bot GoBot) SendAnimation(params SendAnimationParams) (*Message, error) {
 }
 
 type SendVoiceParams struct {
-	ChatId                   interface{}      `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Voice                    interface{}      `json:"voice"`                                 // Audio file to send. Pass a file_id as String to send a file that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
-	Caption                  string           `json:"caption,omitempty"`                     // Optional. Voice message caption, 0-1024 characters after entities parsing
-	ParseMode                string           `json:"parse_mode,omitempty"`                  // Optional. Mode for parsing entities in the voice message caption. See formatting options for more details.
-	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`            // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	Duration                 int              `json:"duration,omitempty"`                    // Optional. Duration of the voice message in seconds
-	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{}      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`        // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                                 // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`             // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Voice                    interface{}      `json:"voice"`                                   // Audio file to send. Pass a file_id as String to send a file that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
+	Caption                  string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Voice message caption, 0-1024 characters after entities parsing
+	ParseMode                string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the voice message caption. See formatting options for more details.
+	CaptionEntities          []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	Duration                 int              `json:"duration,omitempty"`                      // Optional. Duration of the voice message in seconds
+	DisableNotification      bool             `json:"disable_notification,omitempty"`          // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`               // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`             // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`           // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`   // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`              // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                  // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendVoice(params SendVoiceParams) (*Message, error) {
@@ -299,15 +389,20 @@ func (bot GoBot) SendVoice(params SendVoiceParams) (*Message, error) {
 }
 
 type SendVideoNoteParams struct {
-	ChatId                   interface{} `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	VideoNote                interface{} `json:"video_note"`                            // Video note to send. Pass a file_id as String to send a video note that exists on the Telegram servers (recommended) or upload a new video using multipart/form-data. More info on Sending Files ». Sending video notes by a URL is currently unsupported
-	Duration                 int         `json:"duration,omitempty"`                    // Optional. Duration of sent video in seconds
-	Length                   int         `json:"length,omitempty"`                      // Optional. Video width and height, i.e. diameter of the video message
-	Thumb                    interface{} `json:"thumb,omitempty"`                       // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	DisableNotification      bool        `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int         `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool        `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{} `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`      // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`           // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	VideoNote                interface{}      `json:"video_note"`                            // Video note to send. Pass a file_id as String to send a video note that exists on the Telegram servers (recommended) or upload a new video using multipart/form-data. More info on Sending Files ». Sending video notes by a URL is currently unsupported
+	Duration                 int              `json:"duration,omitempty"`                    // Optional. Duration of sent video in seconds
+	Length                   int              `json:"length,omitempty"`                      // Optional. Video width and height, i.e. diameter of the video message
+	Thumbnail                interface{}      `json:"thumbnail,omitempty"`                   // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`             // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`           // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`            // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendVideoNote(params SendVideoNoteParams) (*Message, error) {
@@ -320,12 +415,43 @@ func (bot GoBot) SendVideoNote(params SendVideoNoteParams) (*Message, error) {
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
+type SendPaidMediaParams struct {
+	BusinessConnectionId  string           `json:"business_connection_id,omitempty"`         // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                *ChatId          `json:"chat_id"`                                  // Unique identifier for the target chat or username of the target channel (in the format @channelusername). If the chat is a channel, all Telegram Star proceeds from this media will be credited to the chat's balance. Otherwise, they will be credited to the bot's balance.
+	StarCount             int              `json:"star_count" validate:"range=1-2500"`       // The number of Telegram Stars that must be paid to buy access to the media; 1-2500
+	Media                 []InputPaidMedia `json:"media" validate:"len=1-10"`                // A JSON-serialized array describing the media to be sent; up to 10 items
+	Payload               string           `json:"payload,omitempty" validate:"bytes=0-128"` // Optional. Bot-defined paid media payload, 0-128 bytes. This will not be displayed to the user, use it for your internal processes.
+	Caption               string           `json:"caption,omitempty" validate:"len=0-1024"`  // Optional. Media caption, 0-1024 characters after entities parsing
+	ParseMode             string           `json:"parse_mode,omitempty"`                     // Optional. Mode for parsing entities in the media caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`               // Optional. A JSON-serialized list of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`       // Optional. Pass True, if the caption must be shown above the message media
+	DisableNotification   bool             `json:"disable_notification,omitempty"`           // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent        bool             `json:"protect_content,omitempty"`                // Optional. Protects the contents of the sent message from forwarding and saving
+	ReplyParameters       *ReplyParameters `json:"reply_parameters,omitempty"`               // Optional. Description of the message to reply to
+	ReplyMarkup           ReplyMarkup      `json:"reply_markup,omitempty"`                   // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove a reply keyboard or to force a reply from the user
+}
+
+func (bot GoBot) SendPaidMedia(params SendPaidMediaParams) (*Message, error) {
+	response, err := bot.Request("sendPaidMedia", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *Message
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type SendMediaGroupParams struct {
-	ChatId                   interface{}   `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Media                    []interface{} `json:"media"`                                 // A JSON-serialized array describing messages to be sent, must include 2-10 items
-	DisableNotification      bool          `json:"disable_notification,omitempty"`        // Optional. Sends messages silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int           `json:"reply_to_message_id,omitempty"`         // Optional. If the messages are a reply, ID of the original message
-	AllowSendingWithoutReply bool          `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`      // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`           // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Media                    []InputMedia     `json:"media" validate:"len=2-10"`             // A JSON-serialized array describing messages to be sent, must include 2-10 items
+	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends messages silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`             // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`           // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`            // Optional. Description of the message to reply to
 }
 
 func (bot GoBot) SendMediaGroup(params SendMediaGroupParams) ([]*Message, error) {
@@ -339,17 +465,22 @@ func (bot GoBot) SendMediaGroup(params SendMediaGroupParams) ([]*Message, error)
 }
 
 type SendLocationParams struct {
-	ChatId                   interface{} `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Latitude                 float64     `json:"latitude"`                              // Latitude of the location
-	Longitude                float64     `json:"longitude"`                             // Longitude of the location
-	HorizontalAccuracy       float64     `json:"horizontal_accuracy,omitempty"`         // Optional. The radius of uncertainty for the location, measured in meters; 0-1500
-	LivePeriod               int         `json:"live_period,omitempty"`                 // Optional. Period in seconds for which the location will be updated (see Live Locations, should be between 60 and 86400.
-	Heading                  int         `json:"heading,omitempty"`                     // Optional. For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
-	ProximityAlertRadius     int         `json:"proximity_alert_radius,omitempty"`      // Optional. For live locations, a maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
-	DisableNotification      bool        `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int         `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool        `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{} `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`                           // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                                                    // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`                                // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Latitude                 float64          `json:"latitude"`                                                   // Latitude of the location
+	Longitude                float64          `json:"longitude"`                                                  // Longitude of the location
+	HorizontalAccuracy       float64          `json:"horizontal_accuracy,omitempty" validate:"range=0-1500"`      // Optional. The radius of uncertainty for the location, measured in meters; 0-1500
+	LivePeriod               int              `json:"live_period,omitempty"`                                      // Optional. Period in seconds for which the location will be updated (see Live Locations, should be between 60 and 86400.
+	Heading                  int              `json:"heading,omitempty" validate:"range=1-360"`                   // Optional. For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	ProximityAlertRadius     int              `json:"proximity_alert_radius,omitempty" validate:"range=1-100000"` // Optional. For live locations, a maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
+	DisableNotification      bool             `json:"disable_notification,omitempty"`                             // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`                                  // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`                                // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`                              // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`                      // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`                                 // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                                     // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendLocation(params SendLocationParams) (*Message, error) {
@@ -363,58 +494,66 @@ func (bot GoBot) SendLocation(params SendLocationParams) (*Message, error) {
 }
 
 type EditMessageLiveLocationParams struct {
-	ChatId               interface{}           `json:"chat_id,omitempty"`                // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId            int                   `json:"message_id,omitempty"`             // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
-	InlineMessageId      string                `json:"inline_message_id,omitempty"`      // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
-	Latitude             float64               `json:"latitude"`                         // Latitude of new location
-	Longitude            float64               `json:"longitude"`                        // Longitude of new location
-	HorizontalAccuracy   float64               `json:"horizontal_accuracy,omitempty"`    // Optional. The radius of uncertainty for the location, measured in meters; 0-1500
-	Heading              int                   `json:"heading,omitempty"`                // Optional. Direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
-	ProximityAlertRadius int                   `json:"proximity_alert_radius,omitempty"` // Optional. Maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
-	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`           // Optional. A JSON-serialized object for a new inline keyboard.
-}
-
-func (bot GoBot) EditMessageLiveLocation(params EditMessageLiveLocationParams) (interface{}, error) {
+	BusinessConnectionId string                `json:"business_connection_id,omitempty"`                           // Optional. Unique identifier of the business connection on behalf of which the message to be edited was sent
+	ChatId               *ChatId               `json:"chat_id,omitempty"`                                          // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId            int                   `json:"message_id,omitempty"`                                       // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	InlineMessageId      string                `json:"inline_message_id,omitempty"`                                // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	Latitude             float64               `json:"latitude"`                                                   // Latitude of new location
+	Longitude            float64               `json:"longitude"`                                                  // Longitude of new location
+	LivePeriod           int                   `json:"live_period,omitempty"`                                      // Optional. New period in seconds during which the location can be updated, starting from the message send date. If 0x7FFFFFFF is specified, then the location can be updated forever. Otherwise, the new value must not exceed the current live_period by more than a day, and the live location expiration date must remain within the next 90 days. If not specified, then live_period remains unchanged
+	HorizontalAccuracy   float64               `json:"horizontal_accuracy,omitempty" validate:"range=0-1500"`      // Optional. The radius of uncertainty for the location, measured in meters; 0-1500
+	Heading              int                   `json:"heading,omitempty" validate:"range=1-360"`                   // Optional. Direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	ProximityAlertRadius int                   `json:"proximity_alert_radius,omitempty" validate:"range=1-100000"` // Optional. Maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                                     // Optional. A JSON-serialized object for a new inline keyboard.
+}
+
+func (bot GoBot) EditMessageLiveLocation(params EditMessageLiveLocationParams) (*EditResult, error) {
 	response, err := bot.Request("editMessageLiveLocation", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse interface{}
+	var parsedResponse *EditResult
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
 type StopMessageLiveLocationParams struct {
-	ChatId          interface{}           `json:"chat_id,omitempty"`           // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId       int                   `json:"message_id,omitempty"`        // Optional. Required if inline_message_id is not specified. Identifier of the message with live location to stop
-	InlineMessageId string                `json:"inline_message_id,omitempty"` // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
-	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`      // Optional. A JSON-serialized object for a new inline keyboard.
+	BusinessConnectionId string                `json:"business_connection_id,omitempty"` // Optional. Unique identifier of the business connection on behalf of which the message to be edited was sent
+	ChatId               *ChatId               `json:"chat_id,omitempty"`                // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId            int                   `json:"message_id,omitempty"`             // Optional. Required if inline_message_id is not specified. Identifier of the message with live location to stop
+	InlineMessageId      string                `json:"inline_message_id,omitempty"`      // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`           // Optional. A JSON-serialized object for a new inline keyboard.
 }
 
-func (bot GoBot) StopMessageLiveLocation(params StopMessageLiveLocationParams) (interface{}, error) {
+func (bot GoBot) StopMessageLiveLocation(params StopMessageLiveLocationParams) (*EditResult, error) {
 	response, err := bot.Request("stopMessageLiveLocation", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse interface{}
+	var parsedResponse *EditResult
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
 type SendVenueParams struct {
-	ChatId                   interface{} `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Latitude                 float64     `json:"latitude"`                              // Latitude of the venue
-	Longitude                float64     `json:"longitude"`                             // Longitude of the venue
-	Title                    string      `json:"title"`                                 // Name of the venue
-	Address                  string      `json:"address"`                               // Address of the venue
-	FoursquareId             string      `json:"foursquare_id,omitempty"`               // Optional. Foursquare identifier of the venue
-	FoursquareType           string      `json:"foursquare_type,omitempty"`             // Optional. Foursquare type of the venue, if known. (For example, "arts_entertainment/default", "arts_entertainment/aquarium" or "food/icecream".)
-	GooglePlaceId            string      `json:"google_place_id,omitempty"`             // Optional. Google Places identifier of the venue
-	GooglePlaceType          string      `json:"google_place_type,omitempty"`           // Optional. Google Places type of the venue. (See supported types.)
-	DisableNotification      bool        `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int         `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool        `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{} `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`      // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`           // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Latitude                 float64          `json:"latitude"`                              // Latitude of the venue
+	Longitude                float64          `json:"longitude"`                             // Longitude of the venue
+	Title                    string           `json:"title"`                                 // Name of the venue
+	Address                  string           `json:"address"`                               // Address of the venue
+	FoursquareId             string           `json:"foursquare_id,omitempty"`               // Optional. Foursquare identifier of the venue
+	FoursquareType           string           `json:"foursquare_type,omitempty"`             // Optional. Foursquare type of the venue, if known. (For example, "arts_entertainment/default", "arts_entertainment/aquarium" or "food/icecream".)
+	GooglePlaceId            string           `json:"google_place_id,omitempty"`             // Optional. Google Places identifier of the venue
+	GooglePlaceType          string           `json:"google_place_type,omitempty"`           // Optional. Google Places type of the venue. (See supported types.)
+	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`             // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`           // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`            // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendVenue(params SendVenueParams) (*Message, error) {
@@ -428,15 +567,20 @@ func (bot GoBot) SendVenue(params SendVenueParams) (*Message, error) {
 }
 
 type SendContactParams struct {
-	ChatId                   interface{} `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	PhoneNumber              string      `json:"phone_number"`                          // Contact's phone number
-	FirstName                string      `json:"first_name"`                            // Contact's first name
-	LastName                 string      `json:"last_name,omitempty"`                   // Optional. Contact's last name
-	Vcard                    string      `json:"vcard,omitempty"`                       // Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
-	DisableNotification      bool        `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int         `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool        `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{} `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`      // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`           // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	PhoneNumber              string           `json:"phone_number"`                          // Contact's phone number
+	FirstName                string           `json:"first_name"`                            // Contact's first name
+	LastName                 string           `json:"last_name,omitempty"`                   // Optional. Contact's last name
+	Vcard                    string           `json:"vcard,omitempty"`                       // Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
+	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`             // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`           // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`            // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendContact(params SendContactParams) (*Message, error) {
@@ -450,23 +594,30 @@ func (bot GoBot) SendContact(params SendContactParams) (*Message, error) {
 }
 
 type SendPollParams struct {
-	ChatId                   interface{}      `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Question                 string           `json:"question"`                              // Poll question, 1-300 characters
-	Options                  []string         `json:"options"`                               // A JSON-serialized list of answer options, 2-10 strings 1-100 characters each
-	IsAnonymous              bool             `json:"is_anonymous,omitempty"`                // Optional. True, if the poll needs to be anonymous, defaults to True
-	Type                     string           `json:"type,omitempty"`                        // Optional. Poll type, "quiz" or "regular", defaults to "regular"
-	AllowsMultipleAnswers    bool             `json:"allows_multiple_answers,omitempty"`     // Optional. True, if the poll allows multiple answers, ignored for polls in quiz mode, defaults to False
-	CorrectOptionId          int              `json:"correct_option_id,omitempty"`           // Optional. 0-based identifier of the correct answer option, required for polls in quiz mode
-	Explanation              string           `json:"explanation,omitempty"`                 // Optional. Text that is shown when a user chooses an incorrect answer or taps on the lamp icon in a quiz-style poll, 0-200 characters with at most 2 line feeds after entities parsing
-	ExplanationParseMode     string           `json:"explanation_parse_mode,omitempty"`      // Optional. Mode for parsing entities in the explanation. See formatting options for more details.
-	ExplanationEntities      []*MessageEntity `json:"explanation_entities,omitempty"`        // Optional. List of special entities that appear in the poll explanation, which can be specified instead of parse_mode
-	OpenPeriod               int              `json:"open_period,omitempty"`                 // Optional. Amount of time in seconds the poll will be active after creation, 5-600. Can't be used together with close_date.
-	CloseDate                int              `json:"close_date,omitempty"`                  // Optional. Point in time (Unix timestamp) when the poll will be automatically closed. Must be at least 5 and no more than 600 seconds in the future. Can't be used together with open_period.
-	IsClosed                 bool             `json:"is_closed,omitempty"`                   // Optional. Pass True, if the poll needs to be immediately closed. This can be useful for poll preview.
-	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{}      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string             `json:"business_connection_id,omitempty"`             // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId            `json:"chat_id"`                                      // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int                `json:"message_thread_id,omitempty"`                  // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Question                 string             `json:"question" validate:"len=1-300"`                // Poll question, 1-300 characters
+	QuestionParseMode        string             `json:"question_parse_mode,omitempty"`                // Optional. Mode for parsing entities in the question. See formatting options for more details. Currently, only custom emoji entities are allowed
+	QuestionEntities         []*MessageEntity   `json:"question_entities,omitempty"`                  // Optional. A JSON-serialized list of special entities that appear in the poll question. It can be specified instead of question_parse_mode
+	Options                  []*InputPollOption `json:"options" validate:"len=2-10"`                  // A JSON-serialized list of 2-10 answer options
+	IsAnonymous              bool               `json:"is_anonymous,omitempty"`                       // Optional. True, if the poll needs to be anonymous, defaults to True
+	Type                     string             `json:"type,omitempty"`                               // Optional. Poll type, "quiz" or "regular", defaults to "regular"
+	AllowsMultipleAnswers    bool               `json:"allows_multiple_answers,omitempty"`            // Optional. True, if the poll allows multiple answers, ignored for polls in quiz mode, defaults to False
+	CorrectOptionId          int                `json:"correct_option_id,omitempty"`                  // Optional. 0-based identifier of the correct answer option, required for polls in quiz mode
+	Explanation              string             `json:"explanation,omitempty" validate:"len=0-200"`   // Optional. Text that is shown when a user chooses an incorrect answer or taps on the lamp icon in a quiz-style poll, 0-200 characters with at most 2 line feeds after entities parsing
+	ExplanationParseMode     string             `json:"explanation_parse_mode,omitempty"`             // Optional. Mode for parsing entities in the explanation. See formatting options for more details.
+	ExplanationEntities      []*MessageEntity   `json:"explanation_entities,omitempty"`               // Optional. List of special entities that appear in the poll explanation, which can be specified instead of parse_mode
+	OpenPeriod               int                `json:"open_period,omitempty" validate:"range=5-600"` // Optional. Amount of time in seconds the poll will be active after creation, 5-600. Can't be used together with close_date.
+	CloseDate                int                `json:"close_date,omitempty"`                         // Optional. Point in time (Unix timestamp) when the poll will be automatically closed. Must be at least 5 and no more than 600 seconds in the future. Can't be used together with open_period.
+	IsClosed                 bool               `json:"is_closed,omitempty"`                          // Optional. Pass True, if the poll needs to be immediately closed. This can be useful for poll preview.
+	DisableNotification      bool               `json:"disable_notification,omitempty"`               // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool               `json:"protect_content,omitempty"`                    // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string             `json:"message_effect_id,omitempty"`                  // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int                `json:"reply_to_message_id,omitempty"`                // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool               `json:"allow_sending_without_reply,omitempty"`        // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters   `json:"reply_parameters,omitempty"`                   // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup        `json:"reply_markup,omitempty"`                       // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendPoll(params SendPollParams) (*Message, error) {
@@ -480,12 +631,17 @@ func (bot GoBot) SendPoll(params SendPollParams) (*Message, error) {
 }
 
 type SendDiceParams struct {
-	ChatId                   interface{} `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Emoji                    string      `json:"emoji,omitempty"`                       // Optional. Emoji on which the dice throw animation is based. Currently, must be one of "", "", "", "", "", or "". Dice can have values 1-6 for "", "" and "", values 1-5 for "" and "", and values 1-64 for "". Defaults to ""
-	DisableNotification      bool        `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int         `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool        `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{} `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`      // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`           // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Emoji                    string           `json:"emoji,omitempty"`                       // Optional. Emoji on which the dice throw animation is based. Currently, must be one of "", "", "", "", "", or "". Dice can have values 1-6 for "", "" and "", values 1-5 for "" and "", and values 1-64 for "". Defaults to ""
+	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`             // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`           // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`            // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendDice(params SendDiceParams) (*Message, error) {
@@ -499,8 +655,10 @@ func (bot GoBot) SendDice(params SendDiceParams) (*Message, error) {
 }
 
 type SendChatActionParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Action string      `json:"action"`  // Type of action to broadcast. Choose one, depending on what the user is about to receive: typing for text messages, upload_photo for photos, record_video or upload_video for videos, record_voice or upload_voice for voice notes, upload_document for general files, find_location for location data, record_video_note or upload_video_note for video notes.
+	BusinessConnectionId string  `json:"business_connection_id,omitempty"` // Optional. Unique identifier of the business connection on behalf of which the action will be sent
+	ChatId               *ChatId `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId      int     `json:"message_thread_id,omitempty"`      // Optional. Unique identifier for the target message thread; for supergroups only
+	Action               string  `json:"action"`                           // Type of action to broadcast. Choose one, depending on what the user is about to receive: typing for text messages, upload_photo for photos, record_video or upload_video for videos, record_voice or upload_voice for voice notes, upload_document for general files, find_location for location data, record_video_note or upload_video_note for video notes.
 }
 
 func (bot GoBot) SendChatAction(params SendChatActionParams) (bool, error) {
@@ -512,10 +670,26 @@ func (bot GoBot) SendChatAction(params SendChatActionParams) (bool, error) {
 	return true, nil
 }
 
+type SetMessageReactionParams struct {
+	ChatId    *ChatId        `json:"chat_id"`            // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId int            `json:"message_id"`         // Identifier of the target message. If the message belongs to a media group, the reaction is set to the first non-deleted message in the group instead.
+	Reaction  []ReactionType `json:"reaction,omitempty"` // Optional. A JSON-serialized list of reaction types to set on the message. Currently, as non-premium users, bots can set up to one reaction per message. A custom emoji reaction can be used if it is either already present on the message or explicitly allowed by chat administrators. Paid reactions can't be used by bots.
+	IsBig     bool           `json:"is_big,omitempty"`   // Optional. Pass True to set the reaction with a big animation
+}
+
+func (bot GoBot) SetMessageReaction(params SetMessageReactionParams) (bool, error) {
+	_, err := bot.Request("setMessageReaction", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type GetUserProfilePhotosParams struct {
-	UserId int `json:"user_id"`          // Unique identifier of the target user
-	Offset int `json:"offset,omitempty"` // Optional. Sequential number of the first photo to be returned. By default, all photos are returned.
-	Limit  int `json:"limit,omitempty"`  // Optional. Limits the number of photos to be retrieved. Values between 1-100 are accepted. Defaults to 100.
+	UserId int `json:"user_id"`                                // Unique identifier of the target user
+	Offset int `json:"offset,omitempty"`                       // Optional. Sequential number of the first photo to be returned. By default, all photos are returned.
+	Limit  int `json:"limit,omitempty" validate:"range=1-100"` // Optional. Limits the number of photos to be retrieved. Values between 1-100 are accepted. Defaults to 100.
 }
 
 func (bot GoBot) GetUserProfilePhotos(params GetUserProfilePhotosParams) (*UserProfilePhotos, error) {
@@ -543,10 +717,10 @@ func (bot GoBot) GetFile(params GetFileParams) (*File, error) {
 }
 
 type BanChatMemberParams struct {
-	ChatId         interface{} `json:"chat_id"`                   // Unique identifier for the target group or username of the target supergroup or channel (in the format @channelusername)
-	UserId         int         `json:"user_id"`                   // Unique identifier of the target user
-	UntilDate      int         `json:"until_date,omitempty"`      // Optional. Date when the user will be unbanned, unix time. If user is banned for more than 366 days or less than 30 seconds from the current time they are considered to be banned forever. Applied for supergroups and channels only.
-	RevokeMessages bool        `json:"revoke_messages,omitempty"` // Optional. Pass True to delete all messages from the chat for the user that is being removed. If False, the user will be able to see messages in the group that were sent before the user was removed. Always True for supergroups and channels.
+	ChatId         *ChatId `json:"chat_id"`                   // Unique identifier for the target group or username of the target supergroup or channel (in the format @channelusername)
+	UserId         int     `json:"user_id"`                   // Unique identifier of the target user
+	UntilDate      int     `json:"until_date,omitempty"`      // Optional. Date when the user will be unbanned, unix time. If user is banned for more than 366 days or less than 30 seconds from the current time they are considered to be banned forever. Applied for supergroups and channels only.
+	RevokeMessages bool    `json:"revoke_messages,omitempty"` // Optional. Pass True to delete all messages from the chat for the user that is being removed. If False, the user will be able to see messages in the group that were sent before the user was removed. Always True for supergroups and channels.
 }
 
 func (bot GoBot) BanChatMember(params BanChatMemberParams) (bool, error) {
@@ -559,9 +733,9 @@ func (bot GoBot) BanChatMember(params BanChatMemberParams) (bool, error) {
 }
 
 type UnbanChatMemberParams struct {
-	ChatId       interface{} `json:"chat_id"`                  // Unique identifier for the target group or username of the target supergroup or channel (in the format @username)
-	UserId       int         `json:"user_id"`                  // Unique identifier of the target user
-	OnlyIfBanned bool        `json:"only_if_banned,omitempty"` // Optional. Do nothing if the user is not banned
+	ChatId       *ChatId `json:"chat_id"`                  // Unique identifier for the target group or username of the target supergroup or channel (in the format @username)
+	UserId       int     `json:"user_id"`                  // Unique identifier of the target user
+	OnlyIfBanned bool    `json:"only_if_banned,omitempty"` // Optional. Do nothing if the user is not banned
 }
 
 func (bot GoBot) UnbanChatMember(params UnbanChatMemberParams) (bool, error) {
@@ -574,10 +748,11 @@ func (bot GoBot) UnbanChatMember(params UnbanChatMemberParams) (bool, error) {
 }
 
 type RestrictChatMemberParams struct {
-	ChatId      interface{}      `json:"chat_id"`              // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	UserId      int              `json:"user_id"`              // Unique identifier of the target user
-	Permissions *ChatPermissions `json:"permissions"`          // A JSON-serialized object for new user permissions
-	UntilDate   int              `json:"until_date,omitempty"` // Optional. Date when restrictions will be lifted for the user, unix time. If user is restricted for more than 366 days or less than 30 seconds from the current time, they are considered to be restricted forever
+	ChatId                        *ChatId          `json:"chat_id"`                                    // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	UserId                        int              `json:"user_id"`                                    // Unique identifier of the target user
+	Permissions                   *ChatPermissions `json:"permissions"`                                // A JSON-serialized object for new user permissions
+	UseIndependentChatPermissions bool             `json:"use_independent_chat_permissions,omitempty"` // Optional. Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages and can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios, can_send_documents, can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes permissions; the can_send_polls permission will imply the can_send_messages permission.
+	UntilDate                     int              `json:"until_date,omitempty"`                       // Optional. Date when restrictions will be lifted for the user, unix time. If user is restricted for more than 366 days or less than 30 seconds from the current time, they are considered to be restricted forever
 }
 
 func (bot GoBot) RestrictChatMember(params RestrictChatMemberParams) (bool, error) {
@@ -590,19 +765,23 @@ func (bot GoBot) RestrictChatMember(params RestrictChatMemberParams) (bool, erro
 }
 
 type PromoteChatMemberParams struct {
-	ChatId              interface{} `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	UserId              int         `json:"user_id"`                          // Unique identifier of the target user
-	IsAnonymous         bool        `json:"is_anonymous,omitempty"`           // Optional. Pass True, if the administrator's presence in the chat is hidden
-	CanManageChat       bool        `json:"can_manage_chat,omitempty"`        // Optional. Pass True, if the administrator can access the chat event log, chat statistics, message statistics in channels, see channel members, see anonymous administrators in supergroups and ignore slow mode. Implied by any other administrator privilege
-	CanPostMessages     bool        `json:"can_post_messages,omitempty"`      // Optional. Pass True, if the administrator can create channel posts, channels only
-	CanEditMessages     bool        `json:"can_edit_messages,omitempty"`      // Optional. Pass True, if the administrator can edit messages of other users and can pin messages, channels only
-	CanDeleteMessages   bool        `json:"can_delete_messages,omitempty"`    // Optional. Pass True, if the administrator can delete messages of other users
-	CanManageVoiceChats bool        `json:"can_manage_voice_chats,omitempty"` // Optional. Pass True, if the administrator can manage voice chats
-	CanRestrictMembers  bool        `json:"can_restrict_members,omitempty"`   // Optional. Pass True, if the administrator can restrict, ban or unban chat members
-	CanPromoteMembers   bool        `json:"can_promote_members,omitempty"`    // Optional. Pass True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that he has promoted, directly or indirectly (promoted by administrators that were appointed by him)
-	CanChangeInfo       bool        `json:"can_change_info,omitempty"`        // Optional. Pass True, if the administrator can change chat title, photo and other settings
-	CanInviteUsers      bool        `json:"can_invite_users,omitempty"`       // Optional. Pass True, if the administrator can invite new users to the chat
-	CanPinMessages      bool        `json:"can_pin_messages,omitempty"`       // Optional. Pass True, if the administrator can pin messages, supergroups only
+	ChatId              *ChatId `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	UserId              int     `json:"user_id"`                          // Unique identifier of the target user
+	IsAnonymous         bool    `json:"is_anonymous,omitempty"`           // Optional. Pass True, if the administrator's presence in the chat is hidden
+	CanManageChat       bool    `json:"can_manage_chat,omitempty"`        // Optional. Pass True, if the administrator can access the chat event log, chat statistics, message statistics in channels, see channel members, see anonymous administrators in supergroups and ignore slow mode. Implied by any other administrator privilege
+	CanPostMessages     bool    `json:"can_post_messages,omitempty"`      // Optional. Pass True, if the administrator can create channel posts, channels only
+	CanEditMessages     bool    `json:"can_edit_messages,omitempty"`      // Optional. Pass True, if the administrator can edit messages of other users and can pin messages, channels only
+	CanDeleteMessages   bool    `json:"can_delete_messages,omitempty"`    // Optional. Pass True, if the administrator can delete messages of other users
+	CanManageVideoChats bool    `json:"can_manage_video_chats,omitempty"` // Optional. Pass True if the administrator can manage video chats
+	CanRestrictMembers  bool    `json:"can_restrict_members,omitempty"`   // Optional. Pass True, if the administrator can restrict, ban or unban chat members
+	CanPromoteMembers   bool    `json:"can_promote_members,omitempty"`    // Optional. Pass True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that he has promoted, directly or indirectly (promoted by administrators that were appointed by him)
+	CanChangeInfo       bool    `json:"can_change_info,omitempty"`        // Optional. Pass True, if the administrator can change chat title, photo and other settings
+	CanInviteUsers      bool    `json:"can_invite_users,omitempty"`       // Optional. Pass True, if the administrator can invite new users to the chat
+	CanPostStories      bool    `json:"can_post_stories,omitempty"`       // Optional. Pass True if the administrator can post stories to the chat
+	CanEditStories      bool    `json:"can_edit_stories,omitempty"`       // Optional. Pass True if the administrator can edit stories posted by other users, post stories to the chat page, pin chat stories, and access the chat's story archive
+	CanDeleteStories    bool    `json:"can_delete_stories,omitempty"`     // Optional. Pass True if the administrator can delete stories posted by other users
+	CanPinMessages      bool    `json:"can_pin_messages,omitempty"`       // Optional. Pass True, if the administrator can pin messages, supergroups only
+	CanManageTopics     bool    `json:"can_manage_topics,omitempty"`      // Optional. Pass True if the user is allowed to create, rename, close, and reopen forum topics; for supergroups only
 }
 
 func (bot GoBot) PromoteChatMember(params PromoteChatMemberParams) (bool, error) {
@@ -615,9 +794,9 @@ func (bot GoBot) PromoteChatMember(params PromoteChatMemberParams) (bool, error)
 }
 
 type SetChatAdministratorCustomTitleParams struct {
-	ChatId      interface{} `json:"chat_id"`      // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	UserId      int         `json:"user_id"`      // Unique identifier of the target user
-	CustomTitle string      `json:"custom_title"` // New custom title for the administrator; 0-16 characters, emoji are not allowed
+	ChatId      *ChatId `json:"chat_id"`                          // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	UserId      int     `json:"user_id"`                          // Unique identifier of the target user
+	CustomTitle string  `json:"custom_title" validate:"len=0-16"` // New custom title for the administrator; 0-16 characters, emoji are not allowed
 }
 
 func (bot GoBot) SetChatAdministratorCustomTitle(params SetChatAdministratorCustomTitleParams) (bool, error) {
@@ -629,9 +808,38 @@ func (bot GoBot) SetChatAdministratorCustomTitle(params SetChatAdministratorCust
 	return true, nil
 }
 
+type BanChatSenderChatParams struct {
+	ChatId       *ChatId `json:"chat_id"`        // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	SenderChatId int     `json:"sender_chat_id"` // Unique identifier of the target sender chat
+}
+
+func (bot GoBot) BanChatSenderChat(params BanChatSenderChatParams) (bool, error) {
+	_, err := bot.Request("banChatSenderChat", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type UnbanChatSenderChatParams struct {
+	ChatId       *ChatId `json:"chat_id"`        // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	SenderChatId int     `json:"sender_chat_id"` // Unique identifier of the target sender chat
+}
+
+func (bot GoBot) UnbanChatSenderChat(params UnbanChatSenderChatParams) (bool, error) {
+	_, err := bot.Request("unbanChatSenderChat", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type SetChatPermissionsParams struct {
-	ChatId      interface{}      `json:"chat_id"`     // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	Permissions *ChatPermissions `json:"permissions"` // New default chat permissions
+	ChatId                        *ChatId          `json:"chat_id"`                                    // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	Permissions                   *ChatPermissions `json:"permissions"`                                // New default chat permissions
+	UseIndependentChatPermissions bool             `json:"use_independent_chat_permissions,omitempty"` // Optional. Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages and can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios, can_send_documents, can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes permissions; the can_send_polls permission will imply the can_send_messages permission.
 }
 
 func (bot GoBot) SetChatPermissions(params SetChatPermissionsParams) (bool, error) {
@@ -644,7 +852,7 @@ func (bot GoBot) SetChatPermissions(params SetChatPermissionsParams) (bool, erro
 }
 
 type ExportChatInviteLinkParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
 }
 
 func (bot GoBot) ExportChatInviteLink(params ExportChatInviteLinkParams) (string, error) {
@@ -658,9 +866,11 @@ func (bot GoBot) ExportChatInviteLink(params ExportChatInviteLinkParams) (string
 }
 
 type CreateChatInviteLinkParams struct {
-	ChatId      interface{} `json:"chat_id"`                // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ExpireDate  int         `json:"expire_date,omitempty"`  // Optional. Point in time (Unix timestamp) when the link will expire
-	MemberLimit int         `json:"member_limit,omitempty"` // Optional. Maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	ChatId             *ChatId `json:"chat_id"`                                         // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	Name               string  `json:"name,omitempty" validate:"len=0-32"`              // Optional. Invite link name; 0-32 characters
+	ExpireDate         int     `json:"expire_date,omitempty"`                           // Optional. Point in time (Unix timestamp) when the link will expire
+	MemberLimit        int     `json:"member_limit,omitempty" validate:"range=1-99999"` // Optional. Maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	CreatesJoinRequest bool    `json:"creates_join_request,omitempty"`                  // Optional. True, if users joining the chat via the link need to be approved by chat administrators. If True, member_limit can't be specified
 }
 
 func (bot GoBot) CreateChatInviteLink(params CreateChatInviteLinkParams) (*ChatInviteLink, error) {
@@ -674,10 +884,12 @@ func (bot GoBot) CreateChatInviteLink(params CreateChatInviteLinkParams) (*ChatI
 }
 
 type EditChatInviteLinkParams struct {
-	ChatId      interface{} `json:"chat_id"`                // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	InviteLink  string      `json:"invite_link"`            // The invite link to edit
-	ExpireDate  int         `json:"expire_date,omitempty"`  // Optional. Point in time (Unix timestamp) when the link will expire
-	MemberLimit int         `json:"member_limit,omitempty"` // Optional. Maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	ChatId             *ChatId `json:"chat_id"`                                         // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	InviteLink         string  `json:"invite_link"`                                     // The invite link to edit
+	Name               string  `json:"name,omitempty" validate:"len=0-32"`              // Optional. Invite link name; 0-32 characters
+	ExpireDate         int     `json:"expire_date,omitempty"`                           // Optional. Point in time (Unix timestamp) when the link will expire
+	MemberLimit        int     `json:"member_limit,omitempty" validate:"range=1-99999"` // Optional. Maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	CreatesJoinRequest bool    `json:"creates_join_request,omitempty"`                  // Optional. True, if users joining the chat via the link need to be approved by chat administrators. If True, member_limit can't be specified
 }
 
 func (bot GoBot) EditChatInviteLink(params EditChatInviteLinkParams) (*ChatInviteLink, error) {
@@ -690,9 +902,42 @@ func (bot GoBot) EditChatInviteLink(params EditChatInviteLinkParams) (*ChatInvit
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
+type CreateChatSubscriptionInviteLinkParams struct {
+	ChatId             *ChatId `json:"chat_id"`                                    // Unique identifier for the target channel chat or username of the target channel (in the format @channelusername)
+	Name               string  `json:"name,omitempty" validate:"len=0-32"`         // Optional. Invite link name; 0-32 characters
+	SubscriptionPeriod int     `json:"subscription_period"`                        // The number of seconds the subscription will be active for before the next payment. Currently, it must always be 2592000 (30 days).
+	SubscriptionPrice  int     `json:"subscription_price" validate:"range=1-2500"` // The amount of Telegram Stars a user must pay initially and after each subsequent subscription period to be a member of the chat; 1-2500
+}
+
+func (bot GoBot) CreateChatSubscriptionInviteLink(params CreateChatSubscriptionInviteLinkParams) (*ChatInviteLink, error) {
+	response, err := bot.Request("createChatSubscriptionInviteLink", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *ChatInviteLink
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
+type EditChatSubscriptionInviteLinkParams struct {
+	ChatId     *ChatId `json:"chat_id"`                            // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	InviteLink string  `json:"invite_link"`                        // The invite link to edit
+	Name       string  `json:"name,omitempty" validate:"len=0-32"` // Optional. Invite link name; 0-32 characters
+}
+
+func (bot GoBot) EditChatSubscriptionInviteLink(params EditChatSubscriptionInviteLinkParams) (*ChatInviteLink, error) {
+	response, err := bot.Request("editChatSubscriptionInviteLink", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *ChatInviteLink
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type RevokeChatInviteLinkParams struct {
-	ChatId     interface{} `json:"chat_id"`     // Unique identifier of the target chat or username of the target channel (in the format @channelusername)
-	InviteLink string      `json:"invite_link"` // The invite link to revoke
+	ChatId     *ChatId `json:"chat_id"`     // Unique identifier of the target chat or username of the target channel (in the format @channelusername)
+	InviteLink string  `json:"invite_link"` // The invite link to revoke
 }
 
 func (bot GoBot) RevokeChatInviteLink(params RevokeChatInviteLinkParams) (*ChatInviteLink, error) {
@@ -705,8 +950,36 @@ func (bot GoBot) RevokeChatInviteLink(params RevokeChatInviteLinkParams) (*ChatI
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
+type ApproveChatJoinRequestParams struct {
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	UserId int     `json:"user_id"` // Unique identifier of the target user
+}
+
+func (bot GoBot) ApproveChatJoinRequest(params ApproveChatJoinRequestParams) (bool, error) {
+	_, err := bot.Request("approveChatJoinRequest", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type DeclineChatJoinRequestParams struct {
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	UserId int     `json:"user_id"` // Unique identifier of the target user
+}
+
+func (bot GoBot) DeclineChatJoinRequest(params DeclineChatJoinRequestParams) (bool, error) {
+	_, err := bot.Request("declineChatJoinRequest", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type SetChatPhotoParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId *ChatId     `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
 	Photo  interface{} `json:"photo"`   // New chat photo, uploaded using multipart/form-data
 }
 
@@ -720,7 +993,7 @@ func (bot GoBot) SetChatPhoto(params SetChatPhotoParams) (bool, error) {
 }
 
 type DeleteChatPhotoParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
 }
 
 func (bot GoBot) DeleteChatPhoto(params DeleteChatPhotoParams) (bool, error) {
@@ -733,8 +1006,8 @@ func (bot GoBot) DeleteChatPhoto(params DeleteChatPhotoParams) (bool, error) {
 }
 
 type SetChatTitleParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Title  string      `json:"title"`   // New chat title, 1-255 characters
+	ChatId *ChatId `json:"chat_id"`                    // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	Title  string  `json:"title" validate:"len=1-128"` // New chat title, 1-255 characters
 }
 
 func (bot GoBot) SetChatTitle(params SetChatTitleParams) (bool, error) {
@@ -747,8 +1020,8 @@ func (bot GoBot) SetChatTitle(params SetChatTitleParams) (bool, error) {
 }
 
 type SetChatDescriptionParams struct {
-	ChatId      interface{} `json:"chat_id"`               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Description string      `json:"description,omitempty"` // Optional. New chat description, 0-255 characters
+	ChatId      *ChatId `json:"chat_id"`                                    // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	Description string  `json:"description,omitempty" validate:"len=0-255"` // Optional. New chat description, 0-255 characters
 }
 
 func (bot GoBot) SetChatDescription(params SetChatDescriptionParams) (bool, error) {
@@ -761,9 +1034,10 @@ func (bot GoBot) SetChatDescription(params SetChatDescriptionParams) (bool, erro
 }
 
 type PinChatMessageParams struct {
-	ChatId              interface{} `json:"chat_id"`                        // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId           int         `json:"message_id"`                     // Identifier of a message to pin
-	DisableNotification bool        `json:"disable_notification,omitempty"` // Optional. Pass True, if it is not necessary to send a notification to all chat members about the new pinned message. Notifications are always disabled in channels and private chats.
+	BusinessConnectionId string  `json:"business_connection_id,omitempty"` // Optional. Unique identifier of the business connection on behalf of which the message will be pinned
+	ChatId               *ChatId `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId            int     `json:"message_id"`                       // Identifier of a message to pin
+	DisableNotification  bool    `json:"disable_notification,omitempty"`   // Optional. Pass True, if it is not necessary to send a notification to all chat members about the new pinned message. Notifications are always disabled in channels and private chats.
 }
 
 func (bot GoBot) PinChatMessage(params PinChatMessageParams) (bool, error) {
@@ -776,8 +1050,9 @@ func (bot GoBot) PinChatMessage(params PinChatMessageParams) (bool, error) {
 }
 
 type UnpinChatMessageParams struct {
-	ChatId    interface{} `json:"chat_id"`              // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId int         `json:"message_id,omitempty"` // Optional. Identifier of a message to unpin. If not specified, the most recent pinned message (by sending date) will be unpinned.
+	BusinessConnectionId string  `json:"business_connection_id,omitempty"` // Optional. Unique identifier of the business connection on behalf of which the message will be unpinned
+	ChatId               *ChatId `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId            int     `json:"message_id,omitempty"`             // Optional. Identifier of a message to unpin. If not specified, the most recent pinned message (by sending date) will be unpinned.
 }
 
 func (bot GoBot) UnpinChatMessage(params UnpinChatMessageParams) (bool, error) {
@@ -790,7 +1065,7 @@ func (bot GoBot) UnpinChatMessage(params UnpinChatMessageParams) (bool, error) {
 }
 
 type UnpinAllChatMessagesParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
 }
 
 func (bot GoBot) UnpinAllChatMessages(params UnpinAllChatMessagesParams) (bool, error) {
@@ -803,7 +1078,7 @@ func (bot GoBot) UnpinAllChatMessages(params UnpinAllChatMessagesParams) (bool,
 }
 
 type LeaveChatParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
 }
 
 func (bot GoBot) LeaveChat(params LeaveChatParams) (bool, error) {
@@ -816,35 +1091,34 @@ func (bot GoBot) LeaveChat(params LeaveChatParams) (bool, error) {
 }
 
 type GetChatParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
 }
 
-func (bot GoBot) GetChat(params GetChatParams) (*Chat, error) {
+func (bot GoBot) GetChat(params GetChatParams) (*ChatFullInfo, error) {
 	response, err := bot.Request("getChat", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse *Chat
+	var parsedResponse *ChatFullInfo
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
 type GetChatAdministratorsParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
 }
 
-func (bot GoBot) GetChatAdministrators(params GetChatAdministratorsParams) ([]interface{}, error) {
+func (bot GoBot) GetChatAdministrators(params GetChatAdministratorsParams) ([]ChatMember, error) {
 	response, err := bot.Request("getChatAdministrators", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse []interface{}
-	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+	return unmarshalChatMembers(response)
 }
 
 type GetChatMemberCountParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
 }
 
 func (bot GoBot) GetChatMemberCount(params GetChatMemberCountParams) (int, error) {
@@ -858,23 +1132,22 @@ func (bot GoBot) GetChatMemberCount(params GetChatMemberCountParams) (int, error
 }
 
 type GetChatMemberParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
-	UserId int         `json:"user_id"` // Unique identifier of the target user
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	UserId int     `json:"user_id"` // Unique identifier of the target user
 }
 
-func (bot GoBot) GetChatMember(params GetChatMemberParams) (interface{}, error) {
+func (bot GoBot) GetChatMember(params GetChatMemberParams) (ChatMember, error) {
 	response, err := bot.Request("getChatMember", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse interface{}
-	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+	return unmarshalChatMember(response)
 }
 
 type SetChatStickerSetParams struct {
-	ChatId         interface{} `json:"chat_id"`          // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	StickerSetName string      `json:"sticker_set_name"` // Name of the sticker set to be set as the group sticker set
+	ChatId         *ChatId `json:"chat_id"`          // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	StickerSetName string  `json:"sticker_set_name"` // Name of the sticker set to be set as the group sticker set
 }
 
 func (bot GoBot) SetChatStickerSet(params SetChatStickerSetParams) (bool, error) {
@@ -887,7 +1160,7 @@ func (bot GoBot) SetChatStickerSet(params SetChatStickerSetParams) (bool, error)
 }
 
 type DeleteChatStickerSetParams struct {
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
 }
 
 func (bot GoBot) DeleteChatStickerSet(params DeleteChatStickerSetParams) (bool, error) {
@@ -899,12 +1172,190 @@ func (bot GoBot) DeleteChatStickerSet(params DeleteChatStickerSetParams) (bool,
 	return true, nil
 }
 
+func (bot GoBot) GetForumTopicIconStickers() ([]*Sticker, error) {
+	response, err := bot.Request("getForumTopicIconStickers", nil)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse []*Sticker
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
+type CreateForumTopicParams struct {
+	ChatId            *ChatId `json:"chat_id"`                        // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	Name              string  `json:"name" validate:"len=1-128"`      // Topic name, 1-128 characters
+	IconColor         int     `json:"icon_color,omitempty"`           // Optional. Color of the topic icon in RGB format. Currently, must be one of 7322096 (0x6FB9F0), 16766590 (0xFFD67E), 13338331 (0xCB86DB), 9367192 (0x8EEE98), 16749490 (0xFF93B2), or 16478047 (0xFB6F5F)
+	IconCustomEmojiId string  `json:"icon_custom_emoji_id,omitempty"` // Optional. Unique identifier of the custom emoji shown as the topic icon. Use getForumTopicIconStickers to get all allowed custom emoji identifiers.
+}
+
+func (bot GoBot) CreateForumTopic(params CreateForumTopicParams) (*ForumTopic, error) {
+	response, err := bot.Request("createForumTopic", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *ForumTopic
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
+type EditForumTopicParams struct {
+	ChatId            *ChatId `json:"chat_id"`                             // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	MessageThreadId   int     `json:"message_thread_id"`                   // Unique identifier for the target message thread of the forum topic
+	Name              string  `json:"name,omitempty" validate:"len=0-128"` // Optional. New topic name, 0-128 characters. If not specified or empty, the current name of the topic will be kept
+	IconCustomEmojiId string  `json:"icon_custom_emoji_id,omitempty"`      // Optional. New unique identifier of the custom emoji shown as the topic icon. Use getForumTopicIconStickers to get all allowed custom emoji identifiers. Pass an empty string to remove the icon. If not specified, the current icon will be kept
+}
+
+func (bot GoBot) EditForumTopic(params EditForumTopicParams) (bool, error) {
+	_, err := bot.Request("editForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type CloseForumTopicParams struct {
+	ChatId          *ChatId `json:"chat_id"`           // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	MessageThreadId int     `json:"message_thread_id"` // Unique identifier for the target message thread of the forum topic
+}
+
+func (bot GoBot) CloseForumTopic(params CloseForumTopicParams) (bool, error) {
+	_, err := bot.Request("closeForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type ReopenForumTopicParams struct {
+	ChatId          *ChatId `json:"chat_id"`           // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	MessageThreadId int     `json:"message_thread_id"` // Unique identifier for the target message thread of the forum topic
+}
+
+func (bot GoBot) ReopenForumTopic(params ReopenForumTopicParams) (bool, error) {
+	_, err := bot.Request("reopenForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type DeleteForumTopicParams struct {
+	ChatId          *ChatId `json:"chat_id"`           // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	MessageThreadId int     `json:"message_thread_id"` // Unique identifier for the target message thread of the forum topic
+}
+
+func (bot GoBot) DeleteForumTopic(params DeleteForumTopicParams) (bool, error) {
+	_, err := bot.Request("deleteForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type UnpinAllForumTopicMessagesParams struct {
+	ChatId          *ChatId `json:"chat_id"`           // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	MessageThreadId int     `json:"message_thread_id"` // Unique identifier for the target message thread of the forum topic
+}
+
+func (bot GoBot) UnpinAllForumTopicMessages(params UnpinAllForumTopicMessagesParams) (bool, error) {
+	_, err := bot.Request("unpinAllForumTopicMessages", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type EditGeneralForumTopicParams struct {
+	ChatId *ChatId `json:"chat_id"`                   // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	Name   string  `json:"name" validate:"len=1-128"` // New topic name, 1-128 characters
+}
+
+func (bot GoBot) EditGeneralForumTopic(params EditGeneralForumTopicParams) (bool, error) {
+	_, err := bot.Request("editGeneralForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type CloseGeneralForumTopicParams struct {
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+}
+
+func (bot GoBot) CloseGeneralForumTopic(params CloseGeneralForumTopicParams) (bool, error) {
+	_, err := bot.Request("closeGeneralForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type ReopenGeneralForumTopicParams struct {
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+}
+
+func (bot GoBot) ReopenGeneralForumTopic(params ReopenGeneralForumTopicParams) (bool, error) {
+	_, err := bot.Request("reopenGeneralForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type HideGeneralForumTopicParams struct {
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+}
+
+func (bot GoBot) HideGeneralForumTopic(params HideGeneralForumTopicParams) (bool, error) {
+	_, err := bot.Request("hideGeneralForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type UnhideGeneralForumTopicParams struct {
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+}
+
+func (bot GoBot) UnhideGeneralForumTopic(params UnhideGeneralForumTopicParams) (bool, error) {
+	_, err := bot.Request("unhideGeneralForumTopic", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type UnpinAllGeneralForumTopicMessagesParams struct {
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+}
+
+func (bot GoBot) UnpinAllGeneralForumTopicMessages(params UnpinAllGeneralForumTopicMessagesParams) (bool, error) {
+	_, err := bot.Request("unpinAllGeneralForumTopicMessages", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type AnswerCallbackQueryParams struct {
-	CallbackQueryId string `json:"callback_query_id"`    // Unique identifier for the query to be answered
-	Text            string `json:"text,omitempty"`       // Optional. Text of the notification. If not specified, nothing will be shown to the user, 0-200 characters
-	ShowAlert       bool   `json:"show_alert,omitempty"` // Optional. If true, an alert will be shown by the client instead of a notification at the top of the chat screen. Defaults to false.
-	Url             string `json:"url,omitempty"`        // Optional. URL that will be opened by the user's client. If you have created a Game and accepted the conditions via @Botfather, specify the URL that opens your game — note that this will only work if the query comes from a callback_game button.Otherwise, you may use links like t.me/your_bot?start=XXXX that open your bot with a parameter.
-	CacheTime       int    `json:"cache_time,omitempty"` // Optional. The maximum amount of time in seconds that the result of the callback query may be cached client-side. Telegram apps will support caching starting in version 3.14. Defaults to 0.
+	CallbackQueryId string `json:"callback_query_id"`                   // Unique identifier for the query to be answered
+	Text            string `json:"text,omitempty" validate:"len=0-200"` // Optional. Text of the notification. If not specified, nothing will be shown to the user, 0-200 characters
+	ShowAlert       bool   `json:"show_alert,omitempty"`                // Optional. If true, an alert will be shown by the client instead of a notification at the top of the chat screen. Defaults to false.
+	Url             string `json:"url,omitempty"`                       // Optional. URL that will be opened by the user's client. If you have created a Game and accepted the conditions via @Botfather, specify the URL that opens your game — note that this will only work if the query comes from a callback_game button.Otherwise, you may use links like t.me/your_bot?start=XXXX that open your bot with a parameter.
+	CacheTime       int    `json:"cache_time,omitempty"`                // Optional. The maximum amount of time in seconds that the result of the callback query may be cached client-side. Telegram apps will support caching starting in version 3.14. Defaults to 0.
 }
 
 func (bot GoBot) AnswerCallbackQuery(params AnswerCallbackQueryParams) (bool, error) {
@@ -916,10 +1367,39 @@ func (bot GoBot) AnswerCallbackQuery(params AnswerCallbackQueryParams) (bool, er
 	return true, nil
 }
 
+type GetUserChatBoostsParams struct {
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the chat or username of the channel (in the format @channelusername)
+	UserId int     `json:"user_id"` // Unique identifier of the target user
+}
+
+func (bot GoBot) GetUserChatBoosts(params GetUserChatBoostsParams) (*UserChatBoosts, error) {
+	response, err := bot.Request("getUserChatBoosts", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *UserChatBoosts
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
+type GetBusinessConnectionParams struct {
+	BusinessConnectionId string `json:"business_connection_id"` // Unique identifier of the business connection
+}
+
+func (bot GoBot) GetBusinessConnection(params GetBusinessConnectionParams) (*BusinessConnection, error) {
+	response, err := bot.Request("getBusinessConnection", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *BusinessConnection
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type SetMyCommandsParams struct {
-	Commands     []*BotCommand `json:"commands"`                // A JSON-serialized list of bot commands to be set as the list of the bot's commands. At most 100 commands can be specified.
-	Scope        interface{}   `json:"scope,omitempty"`         // Optional. A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
-	LanguageCode string        `json:"language_code,omitempty"` // Optional. A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope, for whose language there are no dedicated commands
+	Commands     []*BotCommand   `json:"commands" validate:"len=0-100"` // A JSON-serialized list of bot commands to be set as the list of the bot's commands. At most 100 commands can be specified.
+	Scope        BotCommandScope `json:"scope,omitempty"`               // Optional. A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
+	LanguageCode string          `json:"language_code,omitempty"`       // Optional. A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope, for whose language there are no dedicated commands
 }
 
 func (bot GoBot) SetMyCommands(params SetMyCommandsParams) (bool, error) {
@@ -932,8 +1412,8 @@ func (bot GoBot) SetMyCommands(params SetMyCommandsParams) (bool, error) {
 }
 
 type DeleteMyCommandsParams struct {
-	Scope        interface{} `json:"scope,omitempty"`         // Optional. A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
-	LanguageCode string      `json:"language_code,omitempty"` // Optional. A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope, for whose language there are no dedicated commands
+	Scope        BotCommandScope `json:"scope,omitempty"`         // Optional. A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
+	LanguageCode string          `json:"language_code,omitempty"` // Optional. A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope, for whose language there are no dedicated commands
 }
 
 func (bot GoBot) DeleteMyCommands(params DeleteMyCommandsParams) (bool, error) {
@@ -946,8 +1426,8 @@ func (bot GoBot) DeleteMyCommands(params DeleteMyCommandsParams) (bool, error) {
 }
 
 type GetMyCommandsParams struct {
-	Scope        interface{} `json:"scope,omitempty"`         // Optional. A JSON-serialized object, describing scope of users. Defaults to BotCommandScopeDefault.
-	LanguageCode string      `json:"language_code,omitempty"` // Optional. A two-letter ISO 639-1 language code or an empty string
+	Scope        BotCommandScope `json:"scope,omitempty"`         // Optional. A JSON-serialized object, describing scope of users. Defaults to BotCommandScopeDefault.
+	LanguageCode string          `json:"language_code,omitempty"` // Optional. A two-letter ISO 639-1 language code or an empty string
 }
 
 func (bot GoBot) GetMyCommands(params GetMyCommandsParams) ([]*BotCommand, error) {
@@ -960,86 +1440,232 @@ func (bot GoBot) GetMyCommands(params GetMyCommandsParams) ([]*BotCommand, error
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
+type SetMyNameParams struct {
+	Name         string `json:"name,omitempty" validate:"len=0-64"` // Optional. New bot name; 0-64 characters. Pass an empty string to remove the dedicated name for the given language.
+	LanguageCode string `json:"language_code,omitempty"`            // Optional. A two-letter ISO 639-1 language code. If empty, the name will be shown to all users for whose language there is no dedicated name.
+}
+
+func (bot GoBot) SetMyName(params SetMyNameParams) (bool, error) {
+	_, err := bot.Request("setMyName", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type GetMyNameParams struct {
+	LanguageCode string `json:"language_code,omitempty"` // Optional. A two-letter ISO 639-1 language code or an empty string
+}
+
+func (bot GoBot) GetMyName(params GetMyNameParams) (*BotName, error) {
+	response, err := bot.Request("getMyName", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *BotName
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
+type SetMyDescriptionParams struct {
+	Description  string `json:"description,omitempty" validate:"len=0-512"` // Optional. New bot description; 0-512 characters. Pass an empty string to remove the dedicated description for the given language.
+	LanguageCode string `json:"language_code,omitempty"`                    // Optional. A two-letter ISO 639-1 language code. If empty, the description will be applied to all users for whose language there is no dedicated description.
+}
+
+func (bot GoBot) SetMyDescription(params SetMyDescriptionParams) (bool, error) {
+	_, err := bot.Request("setMyDescription", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type GetMyDescriptionParams struct {
+	LanguageCode string `json:"language_code,omitempty"` // Optional. A two-letter ISO 639-1 language code or an empty string
+}
+
+func (bot GoBot) GetMyDescription(params GetMyDescriptionParams) (*BotDescription, error) {
+	response, err := bot.Request("getMyDescription", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *BotDescription
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
+type SetMyShortDescriptionParams struct {
+	ShortDescription string `json:"short_description,omitempty" validate:"len=0-120"` // Optional. New short description for the bot; 0-120 characters. Pass an empty string to remove the dedicated short description for the given language.
+	LanguageCode     string `json:"language_code,omitempty"`                          // Optional. A two-letter ISO 639-1 language code. If empty, the short description will be applied to all users for whose language there is no dedicated short description.
+}
+
+func (bot GoBot) SetMyShortDescription(params SetMyShortDescriptionParams) (bool, error) {
+	_, err := bot.Request("setMyShortDescription", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type GetMyShortDescriptionParams struct {
+	LanguageCode string `json:"language_code,omitempty"` // Optional. A two-letter ISO 639-1 language code or an empty string
+}
+
+func (bot GoBot) GetMyShortDescription(params GetMyShortDescriptionParams) (*BotShortDescription, error) {
+	response, err := bot.Request("getMyShortDescription", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *BotShortDescription
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
+type SetChatMenuButtonParams struct {
+	ChatId     int        `json:"chat_id,omitempty"`     // Optional. Unique identifier for the target private chat. If not specified, default bot's menu button will be changed
+	MenuButton MenuButton `json:"menu_button,omitempty"` // Optional. A JSON-serialized object for the bot's new menu button. Defaults to MenuButtonDefault
+}
+
+func (bot GoBot) SetChatMenuButton(params SetChatMenuButtonParams) (bool, error) {
+	_, err := bot.Request("setChatMenuButton", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type GetChatMenuButtonParams struct {
+	ChatId int `json:"chat_id,omitempty"` // Optional. Unique identifier for the target private chat. If not specified, default bot's menu button will be returned
+}
+
+func (bot GoBot) GetChatMenuButton(params GetChatMenuButtonParams) (MenuButton, error) {
+	response, err := bot.Request("getChatMenuButton", params)
+
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMenuButton(response)
+}
+
+type SetMyDefaultAdministratorRightsParams struct {
+	Rights      *ChatAdministratorRights `json:"rights,omitempty"`       // Optional. A JSON-serialized object describing new default administrator rights. If not specified, the default administrator rights will be cleared.
+	ForChannels bool                     `json:"for_channels,omitempty"` // Optional. Pass True to change the default administrator rights of the bot in channels. Otherwise, the default administrator rights of the bot for groups and supergroups will be changed.
+}
+
+func (bot GoBot) SetMyDefaultAdministratorRights(params SetMyDefaultAdministratorRightsParams) (bool, error) {
+	_, err := bot.Request("setMyDefaultAdministratorRights", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type GetMyDefaultAdministratorRightsParams struct {
+	ForChannels bool `json:"for_channels,omitempty"` // Optional. Pass True to get default administrator rights of the bot in channels. Otherwise, default administrator rights of the bot for groups and supergroups will be returned.
+}
+
+func (bot GoBot) GetMyDefaultAdministratorRights(params GetMyDefaultAdministratorRightsParams) (*ChatAdministratorRights, error) {
+	response, err := bot.Request("getMyDefaultAdministratorRights", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *ChatAdministratorRights
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type EditMessageTextParams struct {
-	ChatId                interface{}           `json:"chat_id,omitempty"`                  // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	BusinessConnectionId  string                `json:"business_connection_id,omitempty"`   // Optional. Unique identifier of the business connection on behalf of which the message to be edited was sent
+	ChatId                *ChatId               `json:"chat_id,omitempty"`                  // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
 	MessageId             int                   `json:"message_id,omitempty"`               // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
 	InlineMessageId       string                `json:"inline_message_id,omitempty"`        // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
-	Text                  string                `json:"text"`                               // New text of the message, 1-4096 characters after entities parsing
+	Text                  string                `json:"text" validate:"len=1-4096"`         // New text of the message, 1-4096 characters after entities parsing
 	ParseMode             string                `json:"parse_mode,omitempty"`               // Optional. Mode for parsing entities in the message text. See formatting options for more details.
 	Entities              []*MessageEntity      `json:"entities,omitempty"`                 // Optional. List of special entities that appear in message text, which can be specified instead of parse_mode
-	DisableWebPagePreview bool                  `json:"disable_web_page_preview,omitempty"` // Optional. Disables link previews for links in this message
+	LinkPreviewOptions    *LinkPreviewOptions   `json:"link_preview_options,omitempty"`     // Optional. Link preview generation options for the message
+	DisableWebPagePreview bool                  `json:"disable_web_page_preview,omitempty"` // Optional. Deprecated, use LinkPreviewOptions. Disables link previews for links in this message
 	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`             // Optional. A JSON-serialized object for an inline keyboard.
 }
 
-func (bot GoBot) EditMessageText(params EditMessageTextParams) (interface{}, error) {
+func (bot GoBot) EditMessageText(params EditMessageTextParams) (*EditResult, error) {
 	response, err := bot.Request("editMessageText", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse interface{}
+	var parsedResponse *EditResult
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
 type EditMessageCaptionParams struct {
-	ChatId          interface{}           `json:"chat_id,omitempty"`           // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId       int                   `json:"message_id,omitempty"`        // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
-	InlineMessageId string                `json:"inline_message_id,omitempty"` // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
-	Caption         string                `json:"caption,omitempty"`           // Optional. New caption of the message, 0-1024 characters after entities parsing
-	ParseMode       string                `json:"parse_mode,omitempty"`        // Optional. Mode for parsing entities in the message caption. See formatting options for more details.
-	CaptionEntities []*MessageEntity      `json:"caption_entities,omitempty"`  // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`      // Optional. A JSON-serialized object for an inline keyboard.
-}
-
-func (bot GoBot) EditMessageCaption(params EditMessageCaptionParams) (interface{}, error) {
+	BusinessConnectionId  string                `json:"business_connection_id,omitempty"`        // Optional. Unique identifier of the business connection on behalf of which the message to be edited was sent
+	ChatId                *ChatId               `json:"chat_id,omitempty"`                       // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId             int                   `json:"message_id,omitempty"`                    // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	InlineMessageId       string                `json:"inline_message_id,omitempty"`             // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. New caption of the message, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the message caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media. Supported only for animation, photo and video messages.
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. A JSON-serialized object for an inline keyboard.
+}
+
+func (bot GoBot) EditMessageCaption(params EditMessageCaptionParams) (*EditResult, error) {
 	response, err := bot.Request("editMessageCaption", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse interface{}
+	var parsedResponse *EditResult
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
 type EditMessageMediaParams struct {
-	ChatId          interface{}           `json:"chat_id,omitempty"`           // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId       int                   `json:"message_id,omitempty"`        // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
-	InlineMessageId string                `json:"inline_message_id,omitempty"` // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
-	Media           interface{}           `json:"media"`                       // A JSON-serialized object for a new media content of the message
-	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`      // Optional. A JSON-serialized object for a new inline keyboard.
+	BusinessConnectionId string                `json:"business_connection_id,omitempty"` // Optional. Unique identifier of the business connection on behalf of which the message to be edited was sent
+	ChatId               *ChatId               `json:"chat_id,omitempty"`                // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId            int                   `json:"message_id,omitempty"`             // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	InlineMessageId      string                `json:"inline_message_id,omitempty"`      // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	Media                InputMedia            `json:"media"`                            // A JSON-serialized object for a new media content of the message
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`           // Optional. A JSON-serialized object for a new inline keyboard.
 }
 
-func (bot GoBot) EditMessageMedia(params EditMessageMediaParams) (interface{}, error) {
+func (bot GoBot) EditMessageMedia(params EditMessageMediaParams) (*EditResult, error) {
 	response, err := bot.Request("editMessageMedia", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse interface{}
+	var parsedResponse *EditResult
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
 type EditMessageReplyMarkupParams struct {
-	ChatId          interface{}           `json:"chat_id,omitempty"`           // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId       int                   `json:"message_id,omitempty"`        // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
-	InlineMessageId string                `json:"inline_message_id,omitempty"` // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
-	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`      // Optional. A JSON-serialized object for an inline keyboard.
+	BusinessConnectionId string                `json:"business_connection_id,omitempty"` // Optional. Unique identifier of the business connection on behalf of which the message to be edited was sent
+	ChatId               *ChatId               `json:"chat_id,omitempty"`                // Optional. Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId            int                   `json:"message_id,omitempty"`             // Optional. Required if inline_message_id is not specified. Identifier of the message to edit
+	InlineMessageId      string                `json:"inline_message_id,omitempty"`      // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`           // Optional. A JSON-serialized object for an inline keyboard.
 }
 
-func (bot GoBot) EditMessageReplyMarkup(params EditMessageReplyMarkupParams) (interface{}, error) {
+func (bot GoBot) EditMessageReplyMarkup(params EditMessageReplyMarkupParams) (*EditResult, error) {
 	response, err := bot.Request("editMessageReplyMarkup", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse interface{}
+	var parsedResponse *EditResult
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
 type StopPollParams struct {
-	ChatId      interface{}           `json:"chat_id"`                // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId   int                   `json:"message_id"`             // Identifier of the original message with the poll
-	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"` // Optional. A JSON-serialized object for a new message inline keyboard.
+	BusinessConnectionId string                `json:"business_connection_id,omitempty"` // Optional. Unique identifier of the business connection on behalf of which the message to be edited was sent
+	ChatId               *ChatId               `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId            int                   `json:"message_id"`                       // Identifier of the original message with the poll
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`           // Optional. A JSON-serialized object for a new message inline keyboard.
 }
 
 func (bot GoBot) StopPoll(params StopPollParams) (*Poll, error) {
@@ -1053,8 +1679,8 @@ func (bot GoBot) StopPoll(params StopPollParams) (*Poll, error) {
 }
 
 type DeleteMessageParams struct {
-	ChatId    interface{} `json:"chat_id"`    // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	MessageId int         `json:"message_id"` // Identifier of the message to delete
+	ChatId    *ChatId `json:"chat_id"`    // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageId int     `json:"message_id"` // Identifier of the message to delete
 }
 
 func (bot GoBot) DeleteMessage(params DeleteMessageParams) (bool, error) {
@@ -1066,13 +1692,33 @@ func (bot GoBot) DeleteMessage(params DeleteMessageParams) (bool, error) {
 	return true, nil
 }
 
+type DeleteMessagesParams struct {
+	ChatId     *ChatId `json:"chat_id"`                          // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageIds []int   `json:"message_ids" validate:"len=1-100"` // A JSON-serialized list of 1-100 identifiers of messages to delete. See deleteMessage for limitations on which messages can be deleted
+}
+
+func (bot GoBot) DeleteMessages(params DeleteMessagesParams) (bool, error) {
+	_, err := bot.Request("deleteMessages", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type SendStickerParams struct {
-	ChatId                   interface{} `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Sticker                  interface{} `json:"sticker"`                               // Sticker to send. Pass a file_id as String to send a file that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a .WEBP file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
-	DisableNotification      bool        `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int         `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool        `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
-	ReplyMarkup              interface{} `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	BusinessConnectionId     string           `json:"business_connection_id,omitempty"`      // Optional. Unique identifier of the business connection on behalf of which the message will be sent
+	ChatId                   *ChatId          `json:"chat_id"`                               // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId          int              `json:"message_thread_id,omitempty"`           // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Sticker                  interface{}      `json:"sticker"`                               // Sticker to send. Pass a file_id as String to send a file that exists on the Telegram servers (recommended), pass an HTTP URL as a String for Telegram to get a .WEBP file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
+	Emoji                    string           `json:"emoji,omitempty"`                       // Optional. Emoji associated with the sticker; only for just uploaded stickers
+	DisableNotification      bool             `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ProtectContent           bool             `json:"protect_content,omitempty"`             // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string           `json:"message_effect_id,omitempty"`           // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int              `json:"reply_to_message_id,omitempty"`         // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters `json:"reply_parameters,omitempty"`            // Optional. Description of the message to reply to
+	ReplyMarkup              ReplyMarkup      `json:"reply_markup,omitempty"`                // Optional. Additional interface options. A JSON-serialized object for an inline keyboard, custom reply keyboard, instructions to remove reply keyboard or to force a reply from the user.
 }
 
 func (bot GoBot) SendSticker(params SendStickerParams) (*Message, error) {
@@ -1099,9 +1745,24 @@ func (bot GoBot) GetStickerSet(params GetStickerSetParams) (*StickerSet, error)
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
+type GetCustomEmojiStickersParams struct {
+	CustomEmojiIds []string `json:"custom_emoji_ids" validate:"len=0-200"` // A JSON-serialized list of custom emoji identifiers. At most 200 custom emoji identifiers can be specified.
+}
+
+func (bot GoBot) GetCustomEmojiStickers(params GetCustomEmojiStickersParams) ([]*Sticker, error) {
+	response, err := bot.Request("getCustomEmojiStickers", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse []*Sticker
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type UploadStickerFileParams struct {
-	UserId     int         `json:"user_id"`     // User identifier of sticker file owner
-	PngSticker interface{} `json:"png_sticker"` // PNG image with the sticker, must be up to 512 kilobytes in size, dimensions must not exceed 512px, and either width or height must be exactly 512px. More info on Sending Files »
+	UserId        int         `json:"user_id"`        // User identifier of sticker file owner
+	Sticker       interface{} `json:"sticker"`        // A file with the sticker in .WEBP, .PNG, .TGS, or .WEBM format. See https://core.telegram.org/stickers for technical requirements. More information on Sending Files »
+	StickerFormat string      `json:"sticker_format"` // Format of the sticker, must be one of "static", "animated", "video"
 }
 
 func (bot GoBot) UploadStickerFile(params UploadStickerFileParams) (*File, error) {
@@ -1115,14 +1776,12 @@ func (bot GoBot) UploadStickerFile(params UploadStickerFileParams) (*File, error
 }
 
 type CreateNewStickerSetParams struct {
-	UserId        int           `json:"user_id"`                  // User identifier of created sticker set owner
-	Name          string        `json:"name"`                     // Short name of sticker set, to be used in t.me/addstickers/ URLs (e.g., animals). Can contain only english letters, digits and underscores. Must begin with a letter, can't contain consecutive underscores and must end in "_by_<bot username>". <bot_username> is case insensitive. 1-64 characters.
-	Title         string        `json:"title"`                    // Sticker set title, 1-64 characters
-	PngSticker    interface{}   `json:"png_sticker,omitempty"`    // Optional. PNG image with the sticker, must be up to 512 kilobytes in size, dimensions must not exceed 512px, and either width or height must be exactly 512px. Pass a file_id as a String to send a file that already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
-	TgsSticker    interface{}   `json:"tgs_sticker,omitempty"`    // Optional. TGS animation with the sticker, uploaded using multipart/form-data. See https://core.telegram.org/animated_stickers#technical-requirements for technical requirements
-	Emojis        string        `json:"emojis"`                   // One or more emoji corresponding to the sticker
-	ContainsMasks bool          `json:"contains_masks,omitempty"` // Optional. Pass True, if a set of mask stickers should be created
-	MaskPosition  *MaskPosition `json:"mask_position,omitempty"`  // Optional. A JSON-serialized object for position where the mask should be placed on faces
+	UserId          int             `json:"user_id"`                      // User identifier of created sticker set owner
+	Name            string          `json:"name" validate:"len=1-64"`     // Short name of sticker set, to be used in t.me/addstickers/ URLs (e.g., animals). Can contain only English letters, digits and underscores. Must begin with a letter, can't contain consecutive underscores and must end in "_by_<bot_username>". <bot_username> is case insensitive. 1-64 characters.
+	Title           string          `json:"title" validate:"len=1-64"`    // Sticker set title, 1-64 characters
+	Stickers        []*InputSticker `json:"stickers" validate:"len=1-50"` // A JSON-serialized list of 1-50 initial stickers to be added to the sticker set
+	StickerType     string          `json:"sticker_type,omitempty"`       // Optional. Type of stickers in the set, pass "regular", "mask", or "custom_emoji". By default, a regular sticker set is created.
+	NeedsRepainting bool            `json:"needs_repainting,omitempty"`   // Optional. Pass True if stickers in the sticker set must be repainted to the color of text when used in messages, the accent color if used as emoji status, white on chat photos, or another appropriate color based on context; for custom emoji sticker sets only
 }
 
 func (bot GoBot) CreateNewStickerSet(params CreateNewStickerSetParams) (bool, error) {
@@ -1135,12 +1794,9 @@ func (bot GoBot) CreateNewStickerSet(params CreateNewStickerSetParams) (bool, er
 }
 
 type AddStickerToSetParams struct {
-	UserId       int           `json:"user_id"`                 // User identifier of sticker set owner
-	Name         string        `json:"name"`                    // Sticker set name
-	PngSticker   interface{}   `json:"png_sticker,omitempty"`   // Optional. PNG image with the sticker, must be up to 512 kilobytes in size, dimensions must not exceed 512px, and either width or height must be exactly 512px. Pass a file_id as a String to send a file that already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files »
-	TgsSticker   interface{}   `json:"tgs_sticker,omitempty"`   // Optional. TGS animation with the sticker, uploaded using multipart/form-data. See https://core.telegram.org/animated_stickers#technical-requirements for technical requirements
-	Emojis       string        `json:"emojis"`                  // One or more emoji corresponding to the sticker
-	MaskPosition *MaskPosition `json:"mask_position,omitempty"` // Optional. A JSON-serialized object for position where the mask should be placed on faces
+	UserId  int           `json:"user_id"` // User identifier of sticker set owner
+	Name    string        `json:"name"`    // Sticker set name
+	Sticker *InputSticker `json:"sticker"` // A JSON-serialized object with information about the added sticker. If exactly the same sticker had already been added to the set, then the set isn't changed.
 }
 
 func (bot GoBot) AddStickerToSet(params AddStickerToSetParams) (bool, error) {
@@ -1152,6 +1808,22 @@ func (bot GoBot) AddStickerToSet(params AddStickerToSetParams) (bool, error) {
 	return true, nil
 }
 
+type ReplaceStickerInSetParams struct {
+	UserId     int           `json:"user_id"`     // User identifier of the sticker set owner
+	Name       string        `json:"name"`        // Sticker set name
+	OldSticker string        `json:"old_sticker"` // File identifier of the replaced sticker
+	Sticker    *InputSticker `json:"sticker"`     // A JSON-serialized object with information about the added sticker. If exactly the same sticker had already been added to the set, then the set remains unchanged.
+}
+
+func (bot GoBot) ReplaceStickerInSet(params ReplaceStickerInSetParams) (bool, error) {
+	_, err := bot.Request("replaceStickerInSet", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type SetStickerPositionInSetParams struct {
 	Sticker  string `json:"sticker"`  // File identifier of the sticker
 	Position int    `json:"position"` // New sticker position in the set, zero-based
@@ -1179,14 +1851,98 @@ func (bot GoBot) DeleteStickerFromSet(params DeleteStickerFromSetParams) (bool,
 	return true, nil
 }
 
-type SetStickerSetThumbParams struct {
-	Name   string      `json:"name"`            // Sticker set name
-	UserId int         `json:"user_id"`         // User identifier of the sticker set owner
-	Thumb  interface{} `json:"thumb,omitempty"` // Optional. A PNG image with the thumbnail, must be up to 128 kilobytes in size and have width and height exactly 100px, or a TGS animation with the thumbnail up to 32 kilobytes in size; see https://core.telegram.org/animated_stickers#technical-requirements for animated sticker technical requirements. Pass a file_id as a String to send a file that already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files ». Animated sticker set thumbnail can't be uploaded via HTTP URL.
+type SetStickerEmojiListParams struct {
+	Sticker   string   `json:"sticker"`                        // File identifier of the sticker
+	EmojiList []string `json:"emoji_list" validate:"len=1-20"` // A JSON-serialized list of 1-20 emoji associated with the sticker
+}
+
+func (bot GoBot) SetStickerEmojiList(params SetStickerEmojiListParams) (bool, error) {
+	_, err := bot.Request("setStickerEmojiList", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type SetStickerKeywordsParams struct {
+	Sticker  string   `json:"sticker"`                                // File identifier of the sticker
+	Keywords []string `json:"keywords,omitempty" validate:"len=0-20"` // Optional. A JSON-serialized list of 0-20 search keywords for the sticker with total length of up to 64 characters
+}
+
+func (bot GoBot) SetStickerKeywords(params SetStickerKeywordsParams) (bool, error) {
+	_, err := bot.Request("setStickerKeywords", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type SetStickerMaskPositionParams struct {
+	Sticker      string        `json:"sticker"`                 // File identifier of the sticker
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"` // Optional. A JSON-serialized object with the position where the mask should be placed on faces. Omit the parameter to remove the mask position.
 }
 
-func (bot GoBot) SetStickerSetThumb(params SetStickerSetThumbParams) (bool, error) {
-	_, err := bot.Request("setStickerSetThumb", params)
+func (bot GoBot) SetStickerMaskPosition(params SetStickerMaskPositionParams) (bool, error) {
+	_, err := bot.Request("setStickerMaskPosition", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type SetStickerSetTitleParams struct {
+	Name  string `json:"name"`                      // Sticker set name
+	Title string `json:"title" validate:"len=1-64"` // Sticker set title, 1-64 characters
+}
+
+func (bot GoBot) SetStickerSetTitle(params SetStickerSetTitleParams) (bool, error) {
+	_, err := bot.Request("setStickerSetTitle", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type SetStickerSetThumbnailParams struct {
+	Name      string      `json:"name"`                // Sticker set name
+	UserId    int         `json:"user_id"`             // User identifier of the sticker set owner
+	Thumbnail interface{} `json:"thumbnail,omitempty"` // Optional. A PNG image with the thumbnail, must be up to 128 kilobytes in size and have width and height exactly 100px, or a TGS animation with the thumbnail up to 32 kilobytes in size; see https://core.telegram.org/animated_stickers#technical-requirements for animated sticker technical requirements. Pass a file_id as a String to send a file that already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload a new one using multipart/form-data. More info on Sending Files ». Animated sticker set thumbnail can't be uploaded via HTTP URL.
+	Format    string      `json:"format"`              // Format of the thumbnail, must be one of "static" for a .WEBP or .PNG image, "animated" for a .TGS animation, or "video" for a WEBM video
+}
+
+func (bot GoBot) SetStickerSetThumbnail(params SetStickerSetThumbnailParams) (bool, error) {
+	_, err := bot.Request("setStickerSetThumbnail", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type SetCustomEmojiStickerSetThumbnailParams struct {
+	Name          string `json:"name"`                      // Sticker set name
+	CustomEmojiId string `json:"custom_emoji_id,omitempty"` // Optional. Custom emoji identifier of a sticker from the sticker set; pass an empty string to drop the thumbnail and use the first sticker as the thumbnail.
+}
+
+func (bot GoBot) SetCustomEmojiStickerSetThumbnail(params SetCustomEmojiStickerSetThumbnailParams) (bool, error) {
+	_, err := bot.Request("setCustomEmojiStickerSetThumbnail", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type DeleteStickerSetParams struct {
+	Name string `json:"name"` // Sticker set name
+}
+
+func (bot GoBot) DeleteStickerSet(params DeleteStickerSetParams) (bool, error) {
+	_, err := bot.Request("deleteStickerSet", params)
 
 	if err != nil {
 		return false, err
@@ -1195,13 +1951,14 @@ func (bot GoBot) SetStickerSetThumb(params SetStickerSetThumbParams) (bool, erro
 }
 
 type AnswerInlineQueryParams struct {
-	InlineQueryId     string        `json:"inline_query_id"`               // Unique identifier for the answered query
-	Results           []interface{} `json:"results"`                       // A JSON-serialized array of results for the inline query
-	CacheTime         int           `json:"cache_time,omitempty"`          // Optional. The maximum amount of time in seconds that the result of the inline query may be cached on the server. Defaults to 300.
-	IsPersonal        bool          `json:"is_personal,omitempty"`         // Optional. Pass True, if results may be cached on the server side only for the user that sent the query. By default, results may be returned to any user who sends the same query
-	NextOffset        string        `json:"next_offset,omitempty"`         // Optional. Pass the offset that a client should send in the next query with the same text to receive more results. Pass an empty string if there are no more results or if you don't support pagination. Offset length can't exceed 64 bytes.
-	SwitchPmText      string        `json:"switch_pm_text,omitempty"`      // Optional. If passed, clients will display a button with specified text that switches the user to a private chat with the bot and sends the bot a start message with the parameter switch_pm_parameter
-	SwitchPmParameter string        `json:"switch_pm_parameter,omitempty"` // Optional. Deep-linking parameter for the /start message sent to the bot when user presses the switch button. 1-64 characters, only A-Z, a-z, 0-9, _ and - are allowed.Example: An inline bot that sends YouTube videos can ask the user to connect the bot to their YouTube account to adapt search results accordingly. To do this, it displays a 'Connect your YouTube account' button above the results, or even before showing any. The user presses the button, switches to a private chat with the bot and, in doing so, passes a start parameter that instructs the bot to return an oauth link. Once done, the bot can offer a switch_inline button so that the user can easily return to the chat where they wanted to use the bot's inline capabilities.
+	InlineQueryId     string                    `json:"inline_query_id"`                             // Unique identifier for the answered query
+	Results           []InlineQueryResult       `json:"results" validate:"len=0-50"`                 // A JSON-serialized array of results for the inline query
+	CacheTime         int                       `json:"cache_time,omitempty"`                        // Optional. The maximum amount of time in seconds that the result of the inline query may be cached on the server. Defaults to 300.
+	IsPersonal        bool                      `json:"is_personal,omitempty"`                       // Optional. Pass True, if results may be cached on the server side only for the user that sent the query. By default, results may be returned to any user who sends the same query
+	NextOffset        string                    `json:"next_offset,omitempty" validate:"bytes=0-64"` // Optional. Pass the offset that a client should send in the next query with the same text to receive more results. Pass an empty string if there are no more results or if you don't support pagination. Offset length can't exceed 64 bytes.
+	Button            *InlineQueryResultsButton `json:"button,omitempty"`                            // Optional. A JSON-serialized object describing a button to be shown above inline query results
+	SwitchPmText      string                    `json:"switch_pm_text,omitempty"`                    // Optional. Deprecated, use Button. If passed, clients will display a button with specified text that switches the user to a private chat with the bot and sends the bot a start message with the parameter switch_pm_parameter
+	SwitchPmParameter string                    `json:"switch_pm_parameter,omitempty"`               // Optional. Deprecated, use Button. Deep-linking parameter for the /start message sent to the bot when user presses the switch button.
 }
 
 func (bot GoBot) AnswerInlineQuery(params AnswerInlineQueryParams) (bool, error) {
@@ -1213,13 +1970,29 @@ func (bot GoBot) AnswerInlineQuery(params AnswerInlineQueryParams) (bool, error)
 	return true, nil
 }
 
+type AnswerWebAppQueryParams struct {
+	WebAppQueryId string            `json:"web_app_query_id"` // Unique identifier for the query to be answered
+	Result        InlineQueryResult `json:"result"`           // A JSON-serialized object describing the message to be sent
+}
+
+func (bot GoBot) AnswerWebAppQuery(params AnswerWebAppQueryParams) (*SentWebAppMessage, error) {
+	response, err := bot.Request("answerWebAppQuery", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *SentWebAppMessage
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type SendInvoiceParams struct {
-	ChatId                    interface{}           `json:"chat_id"`                                 // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	Title                     string                `json:"title"`                                   // Product name, 1-32 characters
-	Description               string                `json:"description"`                             // Product description, 1-255 characters
-	Payload                   string                `json:"payload"`                                 // Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your internal processes.
-	ProviderToken             string                `json:"provider_token"`                          // Payments provider token, obtained via Botfather
-	Currency                  string                `json:"currency"`                                // Three-letter ISO 4217 currency code, see more on currencies
+	ChatId                    *ChatId               `json:"chat_id"`                                 // Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	MessageThreadId           int                   `json:"message_thread_id,omitempty"`             // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	Title                     string                `json:"title" validate:"len=1-32"`               // Product name, 1-32 characters
+	Description               string                `json:"description" validate:"len=1-255"`        // Product description, 1-255 characters
+	Payload                   string                `json:"payload" validate:"bytes=1-128"`          // Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your internal processes.
+	ProviderToken             string                `json:"provider_token,omitempty"`                // Optional. Payment provider token, obtained via @BotFather. Pass an empty string for payments in Telegram Stars.
+	Currency                  string                `json:"currency"`                                // Three-letter ISO 4217 currency code, see more on currencies. Pass "XTR" for payments in Telegram Stars.
 	Prices                    []*LabeledPrice       `json:"prices"`                                  // Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery cost, delivery tax, bonus, etc.)
 	MaxTipAmount              int                   `json:"max_tip_amount,omitempty"`                // Optional. The maximum accepted amount for tips in the smallest units of the currency (integer, not float/double). For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145. See the exp parameter in currencies.json, it shows the number of digits past the decimal point for each currency (2 for the majority of currencies). Defaults to 0
 	SuggestedTipAmounts       []int                 `json:"suggested_tip_amounts,omitempty"`         // Optional. A JSON-serialized array of suggested amounts of tips in the smallest units of the currency (integer, not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must be positive, passed in a strictly increased order and must not exceed max_tip_amount.
@@ -1237,8 +2010,11 @@ type SendInvoiceParams struct {
 	SendEmailToProvider       bool                  `json:"send_email_to_provider,omitempty"`        // Optional. Pass True, if user's email address should be sent to provider
 	IsFlexible                bool                  `json:"is_flexible,omitempty"`                   // Optional. Pass True, if the final price depends on the shipping method
 	DisableNotification       bool                  `json:"disable_notification,omitempty"`          // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId          int                   `json:"reply_to_message_id,omitempty"`           // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply  bool                  `json:"allow_sending_without_reply,omitempty"`   // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
+	ProtectContent            bool                  `json:"protect_content,omitempty"`               // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId           string                `json:"message_effect_id,omitempty"`             // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId          int                   `json:"reply_to_message_id,omitempty"`           // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply  bool                  `json:"allow_sending_without_reply,omitempty"`   // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters           *ReplyParameters      `json:"reply_parameters,omitempty"`              // Optional. Description of the message to reply to
 	ReplyMarkup               *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. A JSON-serialized object for an inline keyboard. If empty, one 'Pay total price' button will be shown. If not empty, the first button must be a Pay button.
 }
 
@@ -1252,6 +2028,39 @@ func (bot GoBot) SendInvoice(params SendInvoiceParams) (*Message, error) {
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
+type CreateInvoiceLinkParams struct {
+	Title                     string          `json:"title" validate:"len=1-32"`               // Product name, 1-32 characters
+	Description               string          `json:"description" validate:"len=1-255"`        // Product description, 1-255 characters
+	Payload                   string          `json:"payload" validate:"bytes=1-128"`          // Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your internal processes.
+	ProviderToken             string          `json:"provider_token,omitempty"`                // Optional. Payment provider token, obtained via @BotFather. Pass an empty string for payments in Telegram Stars.
+	Currency                  string          `json:"currency"`                                // Three-letter ISO 4217 currency code, see more on currencies. Pass "XTR" for payments in Telegram Stars.
+	Prices                    []*LabeledPrice `json:"prices"`                                  // Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery cost, delivery tax, bonus, etc.)
+	MaxTipAmount              int             `json:"max_tip_amount,omitempty"`                // Optional. The maximum accepted amount for tips in the smallest units of the currency (integer, not float/double). For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145. See the exp parameter in currencies.json, it shows the number of digits past the decimal point for each currency (2 for the majority of currencies). Defaults to 0
+	SuggestedTipAmounts       []int           `json:"suggested_tip_amounts,omitempty"`         // Optional. A JSON-serialized array of suggested amounts of tips in the smallest units of the currency (integer, not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must be positive, passed in a strictly increased order and must not exceed max_tip_amount.
+	ProviderData              string          `json:"provider_data,omitempty"`                 // Optional. A JSON-serialized data about the invoice, which will be shared with the payment provider. A detailed description of required fields should be provided by the payment provider.
+	PhotoUrl                  string          `json:"photo_url,omitempty"`                     // Optional. URL of the product photo for the invoice. Can be a photo of the goods or a marketing image for a service. People like it better when they see what they are paying for.
+	PhotoSize                 int             `json:"photo_size,omitempty"`                    // Optional. Photo size
+	PhotoWidth                int             `json:"photo_width,omitempty"`                   // Optional. Photo width
+	PhotoHeight               int             `json:"photo_height,omitempty"`                  // Optional. Photo height
+	NeedName                  bool            `json:"need_name,omitempty"`                     // Optional. Pass True, if you require the user's full name to complete the order
+	NeedPhoneNumber           bool            `json:"need_phone_number,omitempty"`             // Optional. Pass True, if you require the user's phone number to complete the order
+	NeedEmail                 bool            `json:"need_email,omitempty"`                    // Optional. Pass True, if you require the user's email address to complete the order
+	NeedShippingAddress       bool            `json:"need_shipping_address,omitempty"`         // Optional. Pass True, if you require the user's shipping address to complete the order
+	SendPhoneNumberToProvider bool            `json:"send_phone_number_to_provider,omitempty"` // Optional. Pass True, if user's phone number should be sent to provider
+	SendEmailToProvider       bool            `json:"send_email_to_provider,omitempty"`        // Optional. Pass True, if user's email address should be sent to provider
+	IsFlexible                bool            `json:"is_flexible,omitempty"`                   // Optional. Pass True, if the final price depends on the shipping method
+}
+
+func (bot GoBot) CreateInvoiceLink(params CreateInvoiceLinkParams) (string, error) {
+	response, err := bot.Request("createInvoiceLink", params)
+
+	if err != nil {
+		return "", err
+	}
+	var parsedResponse string
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
 type AnswerShippingQueryParams struct {
 	ShippingQueryId string            `json:"shipping_query_id"`          // Unique identifier for the query to be answered
 	Ok              bool              `json:"ok"`                         // Specify True if delivery to the specified address is possible and False if there are any problems (for example, if delivery to the specified address is not possible)
@@ -1283,9 +2092,38 @@ func (bot GoBot) AnswerPreCheckoutQuery(params AnswerPreCheckoutQueryParams) (bo
 	return true, nil
 }
 
+type GetStarTransactionsParams struct {
+	Offset int `json:"offset,omitempty"`                       // Optional. Number of transactions to skip in the response
+	Limit  int `json:"limit,omitempty" validate:"range=1-100"` // Optional. The maximum number of transactions to be retrieved. Values between 1-100 are accepted. Defaults to 100.
+}
+
+func (bot GoBot) GetStarTransactions(params GetStarTransactionsParams) (*StarTransactions, error) {
+	response, err := bot.Request("getStarTransactions", params)
+
+	if err != nil {
+		return nil, err
+	}
+	var parsedResponse *StarTransactions
+	return parsedResponse, json.Unmarshal(response, &parsedResponse)
+}
+
+type RefundStarPaymentParams struct {
+	UserId                  int    `json:"user_id"`                    // Identifier of the user whose payment will be refunded
+	TelegramPaymentChargeId string `json:"telegram_payment_charge_id"` // Telegram payment identifier
+}
+
+func (bot GoBot) RefundStarPayment(params RefundStarPaymentParams) (bool, error) {
+	_, err := bot.Request("refundStarPayment", params)
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type SetPassportDataErrorsParams struct {
-	UserId int           `json:"user_id"` // User identifier
-	Errors []interface{} `json:"errors"`  // A JSON-serialized array describing the errors
+	UserId int                    `json:"user_id"` // User identifier
+	Errors []PassportElementError `json:"errors"`  // A JSON-serialized array describing the errors
 }
 
 func (bot GoBot) SetPassportDataErrors(params SetPassportDataErrorsParams) (bool, error) {
@@ -1298,11 +2136,16 @@ func (bot GoBot) SetPassportDataErrors(params SetPassportDataErrorsParams) (bool
 }
 
 type SendGameParams struct {
+	BusinessConnectionId     string                `json:"business_connection_id,omitempty"`      // Optional. Unique identifier of the business connection on behalf of which the message will be sent
 	ChatId                   int                   `json:"chat_id"`                               // Unique identifier for the target chat
+	MessageThreadId          int                   `json:"message_thread_id,omitempty"`           // Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	GameShortName            string                `json:"game_short_name"`                       // Short name of the game, serves as the unique identifier for the game. Set up your games via Botfather.
 	DisableNotification      bool                  `json:"disable_notification,omitempty"`        // Optional. Sends the message silently. Users will receive a notification with no sound.
-	ReplyToMessageId         int                   `json:"reply_to_message_id,omitempty"`         // Optional. If the message is a reply, ID of the original message
-	AllowSendingWithoutReply bool                  `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True, if the message should be sent even if the specified replied-to message is not found
+	ProtectContent           bool                  `json:"protect_content,omitempty"`             // Optional. Protects the contents of the sent message from forwarding and saving
+	MessageEffectId          string                `json:"message_effect_id,omitempty"`           // Optional. Unique identifier of the message effect to be added to the message; for private chats only
+	ReplyToMessageId         int                   `json:"reply_to_message_id,omitempty"`         // Optional. Deprecated, use ReplyParameters. If the message is a reply, ID of the original message
+	AllowSendingWithoutReply bool                  `json:"allow_sending_without_reply,omitempty"` // Optional. Deprecated, use ReplyParameters. Pass True if the message should be sent even if the specified replied-to message is not found
+	ReplyParameters          *ReplyParameters      `json:"reply_parameters,omitempty"`            // Optional. Description of the message to reply to
 	ReplyMarkup              *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                // Optional. A JSON-serialized object for an inline keyboard. If empty, one 'Play game_title' button will be shown. If not empty, the first button must launch the game.
 }
 
@@ -1326,13 +2169,13 @@ type SetGameScoreParams struct {
 	InlineMessageId    string `json:"inline_message_id,omitempty"`    // Optional. Required if chat_id and message_id are not specified. Identifier of the inline message
 }
 
-func (bot GoBot) SetGameScore(params SetGameScoreParams) (interface{}, error) {
+func (bot GoBot) SetGameScore(params SetGameScoreParams) (*EditResult, error) {
 	response, err := bot.Request("setGameScore", params)
 
 	if err != nil {
 		return nil, err
 	}
-	var parsedResponse interface{}
+	var parsedResponse *EditResult
 	return parsedResponse, json.Unmarshal(response, &parsedResponse)
 }
 
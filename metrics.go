@@ -0,0 +1,95 @@
+package gobot
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics receives the measurements of the bot, e.g. to export them to a monitoring system. Its methods
+// are called concurrently. The bot argument is the name of the bot in its Manager, empty if it isn't managed,
+// so that the bots of a manager can share the same Metrics.
+type Metrics interface {
+	ObserveRequest(bot string, method string, duration time.Duration, err error) // Called after every attempt of a request, with no duration if its params are invalid
+	ObserveRetry(bot string, method string)                                      // Called before retrying a request failed because of flood control
+	ObserveUpdate(bot string, updateType string, duration time.Duration, panicked bool)
+	WatchQueue(bot *GoBot)   // Called by SetMetrics, the depth of the queue can then be read with QueueDepth when collecting
+	UnwatchQueue(bot *GoBot) // Called by SetMetrics when the metrics are replaced, after which the bot mustn't be read anymore
+}
+
+// SetMetrics replaces the metrics of the bot, nil to stop measuring it.
+func (bot *GoBot) SetMetrics(metrics Metrics) {
+	if bot.metrics != nil {
+		bot.metrics.UnwatchQueue(bot)
+	}
+	bot.metrics = metrics
+
+	if metrics != nil {
+		metrics.WatchQueue(bot)
+	}
+}
+
+// QueueDepth returns the number of updates received and not handled yet, including the items of the media
+// groups waiting to be flushed.
+func (bot *GoBot) QueueDepth() int {
+	return int(atomic.LoadInt64(&bot.queueDepth))
+}
+
+// retryAfter returns how long to wait before retrying a request failed because of flood control.
+func retryAfter(err error) (time.Duration, bool) {
+	var apiError *Error
+
+	if !errors.As(err, &apiError) || apiError.ErrorCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if apiError.Parameters == nil || apiError.Parameters.RetryAfter <= 0 {
+		return time.Second, true
+	}
+	return time.Duration(apiError.Parameters.RetryAfter) * time.Second, true
+}
+
+func (bot *GoBot) addQueueDepth(delta int64) {
+	atomic.AddInt64(&bot.queueDepth, delta)
+}
+
+// recoverUpdate recovers a panic of the handlers, logging it and recording it in the span, and reports the
+// handled update to the metrics, unless buffered points to true because the update was buffered in a media
+// group, which is reported when flushed.
+func (bot *GoBot) recoverUpdate(update *Update, start time.Time, span Span, buffered *bool) {
+	recovered := recover()
+	updateType := UpdateType(update)
+	duration := time.Since(start)
+
+	if recovered != nil {
+		bot.logger.Error("Panic while handling update", "update_id", update.UpdateId, "type", updateType,
+			"panic", recovered, "stack", string(debug.Stack()))
+		span.SetError(fmt.Errorf("panic: %v", recovered))
+	} else if *buffered {
+		bot.logger.Debug("Update buffered in a media group", "update_id", update.UpdateId, "type", updateType)
+		return
+	} else {
+		bot.logger.Debug("Update handled", "update_id", update.UpdateId, "type", updateType, "duration", duration)
+	}
+
+	if bot.metrics != nil {
+		bot.metrics.ObserveUpdate(bot.name, updateType, duration, recovered != nil)
+	}
+}
+
+// UpdateType returns the kind of the update, named after its field that is set, e.g. "callback_query".
+func UpdateType(update *Update) string {
+	value := reflect.Indirect(reflect.ValueOf(update))
+
+	for i := 1; i < value.NumField(); i++ {
+		if !value.Field(i).IsNil() {
+			return strings.TrimSuffix(value.Type().Field(i).Tag.Get("json"), ",omitempty")
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,173 @@
+package gobot
+
+import (
+	"strconv"
+)
+
+// PageSource returns at most limit items starting from offset, as buttons, and the total number of items.
+// The key is the one passed to Paginator.Send, e.g. a search query.
+type PageSource func(key string, offset int, limit int) ([]*InlineKeyboardButton, int, error)
+
+// Paginator shows the items of a PageSource a page at a time, with buttons to move between the pages
+// that edit the message in place.
+type Paginator struct {
+	prefix       string
+	PageSize     int
+	Columns      int                                          // Number of item buttons per row, defaults to 1
+	Source       PageSource                                   // Items to paginate
+	Text         func(key string, page int, pages int) string // Optional. Text of the message for a page, if it has to change with the page
+	ParseMode    string                                       // Optional. Parse mode of Text
+	PreviousText string                                       // Text of the previous page button
+	NextText     string                                       // Text of the next page button
+	OnError      func(update *Update, err error)              // Optional. Called when a page can't be shown
+}
+
+type paginatorData struct {
+	Page int
+	Key  string
+	Noop bool
+}
+
+// NewPaginator creates a paginator and registers its callback handler with the given prefix.
+// Its callback data is signed only if the CallbackCodec of the bot has a Secret, otherwise a user can forge it
+// to open any page of any key.
+func NewPaginator(bot *GoBot, prefix string, pageSize int, source PageSource) *Paginator {
+	paginator := &Paginator{
+		prefix:       prefix,
+		PageSize:     pageSize,
+		Columns:      1,
+		Source:       source,
+		PreviousText: "«",
+		NextText:     "»",
+	}
+	bot.OnCallback(prefix, paginator.handle)
+	return paginator
+}
+
+// Page returns the text, empty if Text isn't set, and the keyboard for a page, counting from 0.
+func (paginator *Paginator) Page(bot *GoBot, key string, page int) (string, *InlineKeyboardMarkup, error) {
+	pageSize := paginator.PageSize
+
+	if pageSize <= 0 {
+		pageSize = 1
+	}
+
+	if page < 0 {
+		page = 0
+	}
+	items, total, err := paginator.Source(key, page*pageSize, pageSize)
+
+	if err != nil {
+		return "", nil, err
+	}
+	pages := (total + pageSize - 1) / pageSize
+
+	if pages == 0 {
+		pages = 1
+	}
+	rows := NewInlineKeyboardGrid(paginator.Columns, items...)
+
+	if pages > 1 {
+		var navigation []*InlineKeyboardButton
+
+		if page > 0 {
+			button, err := paginator.button(bot, paginator.PreviousText, paginatorData{Page: page - 1, Key: key})
+
+			if err != nil {
+				return "", nil, err
+			}
+			navigation = append(navigation, button)
+		}
+		button, err := paginator.button(bot, strconv.Itoa(page+1)+"/"+strconv.Itoa(pages), paginatorData{Page: page, Key: key, Noop: true})
+
+		if err != nil {
+			return "", nil, err
+		}
+		navigation = append(navigation, button)
+
+		if page < pages-1 {
+			button, err := paginator.button(bot, paginator.NextText, paginatorData{Page: page + 1, Key: key})
+
+			if err != nil {
+				return "", nil, err
+			}
+			navigation = append(navigation, button)
+		}
+		rows = append(rows, navigation)
+	}
+	var text string
+
+	if paginator.Text != nil {
+		text = paginator.Text(key, page, pages)
+	}
+	return text, NewInlineKeyboardMarkup(rows...), nil
+}
+
+func (paginator *Paginator) button(bot *GoBot, text string, data paginatorData) (*InlineKeyboardButton, error) {
+	callbackData, err := bot.CallbackData(paginator.prefix, data)
+
+	if err != nil {
+		return nil, err
+	}
+	return NewInlineKeyboardButton(text, callbackData, true), nil
+}
+
+// Send sends the first page. The keyboard replaces the reply markup of params, and, if Text is set,
+// the text of the page replaces the text of params.
+func (paginator *Paginator) Send(bot *GoBot, params SendMessageParams, key string) (*Message, error) {
+	text, keyboard, err := paginator.Page(bot, key, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if paginator.Text != nil {
+		params.Text = text
+		params.ParseMode = paginator.ParseMode
+		params.Entities = nil
+	}
+	params.ReplyMarkup = keyboard
+	return bot.SendMessage(params)
+}
+
+func (paginator *Paginator) handle(bot *GoBot, update *Update, data *paginatorData) {
+	query := update.CallbackQuery
+	defer bot.AnswerCallbackQuery(query.NewAnswerCallbackQuery("", false))
+
+	if data.Noop {
+		return
+	}
+	text, keyboard, err := paginator.Page(bot, data.Key, data.Page)
+
+	if err == nil {
+		var chatId *ChatId
+		var messageId int
+
+		if query.Message != nil {
+			chatId = NewChatId(query.Message.Chat.Id)
+			messageId = query.Message.MessageId
+		}
+
+		if paginator.Text != nil {
+			_, err = bot.EditMessageText(EditMessageTextParams{
+				ChatId:          chatId,
+				MessageId:       messageId,
+				InlineMessageId: query.InlineMessageId,
+				Text:            text,
+				ParseMode:       paginator.ParseMode,
+				ReplyMarkup:     keyboard,
+			})
+		} else {
+			_, err = bot.EditMessageReplyMarkup(EditMessageReplyMarkupParams{
+				ChatId:          chatId,
+				MessageId:       messageId,
+				InlineMessageId: query.InlineMessageId,
+				ReplyMarkup:     keyboard,
+			})
+		}
+	}
+
+	if err != nil && paginator.OnError != nil {
+		paginator.OnError(update, err)
+	}
+}
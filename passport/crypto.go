@@ -0,0 +1,109 @@
+// Package passport decrypts the Telegram Passport data shared with a bot and builds the errors to report
+// back to the user with SetPassportDataErrors.
+package passport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+)
+
+var (
+	ErrInvalidKey       = errors.New("invalid RSA private key")
+	ErrInvalidData      = errors.New("encrypted data length is not a multiple of the AES block size")
+	ErrHashMismatch     = errors.New("decrypted data doesn't match its hash")
+	ErrInvalidPadding   = errors.New("invalid padding of decrypted data")
+	ErrNoCredentials    = errors.New("no credentials for this element or file")
+	ErrEmptyCredentials = errors.New("the passport data has no credentials")
+	ErrNonceMismatch    = errors.New("the credentials nonce doesn't match the one of the request")
+	ErrUnknownDataType  = errors.New("element type has no data")
+)
+
+// ParsePrivateKey parses a PEM encoded RSA private key, in either PKCS #1 or PKCS #8 form.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	return rsaKey, nil
+}
+
+func decryptSecret(key *rsa.PrivateKey, encryptedSecret string) ([]byte, error) {
+	secret, err := base64.StdEncoding.DecodeString(encryptedSecret)
+
+	if err != nil {
+		return nil, err
+	}
+	return rsa.DecryptOAEP(sha1.New(), rand.Reader, key, secret, nil)
+}
+
+// decrypt decrypts data with the AES-256-CBC key and iv derived from the secret and the hash, checks that
+// the SHA-256 of the result matches the hash and removes the random padding.
+func decrypt(data []byte, secret []byte, hash []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, ErrInvalidData
+	}
+	digest := sha512.Sum512(append(append([]byte(nil), secret...), hash...))
+	block, err := aes.NewCipher(digest[:32])
+
+	if err != nil {
+		return nil, err
+	}
+	decrypted := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, digest[32:48]).CryptBlocks(decrypted, data)
+	dataHash := sha256.Sum256(decrypted)
+
+	if !bytes.Equal(dataHash[:], hash) {
+		return nil, ErrHashMismatch
+	}
+	padding := int(decrypted[0])
+
+	if padding < 32 || padding > len(decrypted) {
+		return nil, ErrInvalidPadding
+	}
+	return decrypted[padding:], nil
+}
+
+// decryptBase64 decrypts base64 encoded data, secret and hash.
+func decryptBase64(data string, secret string, hash string) ([]byte, error) {
+	decodedData, err := base64.StdEncoding.DecodeString(data)
+
+	if err != nil {
+		return nil, err
+	}
+	decodedSecret, err := base64.StdEncoding.DecodeString(secret)
+
+	if err != nil {
+		return nil, err
+	}
+	decodedHash, err := base64.StdEncoding.DecodeString(hash)
+
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(decodedData, decodedSecret, decodedHash)
+}
@@ -0,0 +1,226 @@
+package passport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+var (
+	testKey     *rsa.PrivateKey
+	testKeyOnce sync.Once
+)
+
+func privateKey(t *testing.T) *rsa.PrivateKey {
+	testKeyOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		testKey = key
+	})
+	return testKey
+}
+
+// encrypt encrypts data the way Telegram does, prepending padding bytes of random data whose first byte
+// is the padding length, and returns the encrypted data with its hash.
+func encrypt(t *testing.T, data []byte, secret []byte, padding int) ([]byte, []byte) {
+	padded := make([]byte, padding+len(data))
+
+	if _, err := rand.Read(padded[:padding]); err != nil {
+		t.Fatal(err)
+	}
+	padded[0] = byte(padding)
+	copy(padded[padding:], data)
+	return encryptPadded(t, padded, secret)
+}
+
+// encryptPadded encrypts already padded data and returns it with its hash.
+func encryptPadded(t *testing.T, padded []byte, secret []byte) ([]byte, []byte) {
+	hash := sha256.Sum256(padded)
+	digest := sha512.Sum512(append(append([]byte(nil), secret...), hash[:]...))
+	block, err := aes.NewCipher(digest[:32])
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, digest[32:48]).CryptBlocks(encrypted, padded)
+	return encrypted, hash[:]
+}
+
+// paddingFor returns the smallest valid padding that aligns data to the AES block size.
+func paddingFor(data []byte) int {
+	padding := 32
+
+	for (padding+len(data))%aes.BlockSize != 0 {
+		padding++
+	}
+	return padding
+}
+
+func testPassportData(t *testing.T, nonce string) *gobot.PassportData {
+	details, _ := json.Marshal(&PersonalDetails{FirstName: "Mario", LastName: "Rossi", BirthDate: "01.01.1990"})
+	dataSecret := bytes.Repeat([]byte{1}, 32)
+	encryptedDetails, detailsHash := encrypt(t, details, dataSecret, paddingFor(details))
+	credentials, _ := json.Marshal(&Credentials{
+		SecureData: map[string]*SecureValue{
+			"personal_details": {Data: &DataCredentials{
+				DataHash: base64.StdEncoding.EncodeToString(detailsHash),
+				Secret:   base64.StdEncoding.EncodeToString(dataSecret),
+			}},
+		},
+		Nonce: nonce,
+	})
+	secret := bytes.Repeat([]byte{2}, 32)
+	encryptedCredentials, credentialsHash := encrypt(t, credentials, secret, paddingFor(credentials))
+	encryptedSecret, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &privateKey(t).PublicKey, secret, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &gobot.PassportData{
+		Data: []*gobot.EncryptedPassportElement{
+			{Type: "personal_details", Data: base64.StdEncoding.EncodeToString(encryptedDetails)},
+			{Type: "email", Email: "mario@example.com"},
+		},
+		Credentials: &gobot.EncryptedCredentials{
+			Data:   base64.StdEncoding.EncodeToString(encryptedCredentials),
+			Hash:   base64.StdEncoding.EncodeToString(credentialsHash),
+			Secret: base64.StdEncoding.EncodeToString(encryptedSecret),
+		},
+	}
+}
+
+func TestDecrypt(t *testing.T) {
+	passport, err := Decrypt(privateKey(t), testPassportData(t, "nonce"), "nonce")
+
+	if err != nil {
+		t.Fatal(err)
+	} else if passport.Credentials.Nonce != "nonce" {
+		t.Errorf("expected the nonce of the request, got %q", passport.Credentials.Nonce)
+	}
+	details := passport.Element("personal_details")
+
+	if details == nil || details.PersonalDetails == nil {
+		t.Fatal("expected the personal details to be decrypted")
+	} else if details.PersonalDetails.FirstName != "Mario" || details.PersonalDetails.BirthDate != "01.01.1990" {
+		t.Errorf("unexpected personal details %+v", details.PersonalDetails)
+	}
+
+	if email := passport.Element("email"); email == nil || email.Email != "mario@example.com" {
+		t.Errorf("expected the email to be kept, got %+v", email)
+	}
+}
+
+func TestDecryptNonceMismatch(t *testing.T) {
+	data := testPassportData(t, "nonce")
+
+	for _, nonce := range []string{"other", ""} {
+		if _, err := Decrypt(privateKey(t), data, nonce); !errors.Is(err, ErrNonceMismatch) {
+			t.Errorf("%q: expected ErrNonceMismatch, got %v", nonce, err)
+		}
+	}
+
+	if _, err := DecryptCredentials(privateKey(t), nil, "nonce"); !errors.Is(err, ErrEmptyCredentials) {
+		t.Errorf("expected ErrEmptyCredentials, got %v", err)
+	}
+}
+
+func TestDecryptPadding(t *testing.T) {
+	secret := bytes.Repeat([]byte{3}, 32)
+	data := []byte("0123456789abcdef")
+	tests := []struct {
+		padding int
+		err     error
+	}{
+		{32, nil},
+		{48, nil},
+		{240, nil},
+		{16, ErrInvalidPadding},
+	}
+
+	for _, test := range tests {
+		encrypted, hash := encrypt(t, data, secret, test.padding)
+		decrypted, err := decrypt(encrypted, secret, hash)
+
+		if !errors.Is(err, test.err) {
+			t.Errorf("padding %d: expected %v, got %v", test.padding, test.err, err)
+		} else if err == nil && !bytes.Equal(decrypted, data) {
+			t.Errorf("padding %d: expected %q, got %q", test.padding, data, decrypted)
+		}
+	}
+
+	// A padding length larger than the data
+	padded := make([]byte, 32)
+	padded[0] = 255
+	encrypted, hash := encryptPadded(t, padded, secret)
+
+	if _, err := decrypt(encrypted, secret, hash); !errors.Is(err, ErrInvalidPadding) {
+		t.Errorf("padding 255 of 32 bytes: expected ErrInvalidPadding, got %v", err)
+	}
+}
+
+func TestDecryptHashMismatch(t *testing.T) {
+	secret := bytes.Repeat([]byte{4}, 32)
+	data := []byte("0123456789abcdef")
+	encrypted, hash := encrypt(t, data, secret, 32)
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(tampered)-1] ^= 1
+
+	if _, err := decrypt(tampered, secret, hash); !errors.Is(err, ErrHashMismatch) {
+		t.Errorf("tampered data: expected ErrHashMismatch, got %v", err)
+	}
+	otherHash := append([]byte(nil), hash...)
+	otherHash[0] ^= 1
+
+	if _, err := decrypt(encrypted, secret, otherHash); !errors.Is(err, ErrHashMismatch) {
+		t.Errorf("wrong hash: expected ErrHashMismatch, got %v", err)
+	}
+
+	if _, err := decrypt(encrypted[:len(encrypted)-1], secret, hash); !errors.Is(err, ErrInvalidData) {
+		t.Errorf("truncated data: expected ErrInvalidData, got %v", err)
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	key := privateKey(t)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks := []*pem.Block{
+		{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)},
+		{Type: "PRIVATE KEY", Bytes: pkcs8},
+	}
+
+	for _, block := range blocks {
+		parsed, err := ParsePrivateKey(pem.EncodeToMemory(block))
+
+		if err != nil {
+			t.Errorf("%s: %v", block.Type, err)
+		} else if !parsed.Equal(key) {
+			t.Errorf("%s: expected the generated key", block.Type)
+		}
+	}
+
+	if _, err := ParsePrivateKey([]byte("not a key")); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("expected ErrInvalidKey, got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package passport
+
+import (
+	"github.com/mattiabrandon/gobot"
+)
+
+// DataFieldError reports an error in a field of the element's data, e.g. "document_no".
+func (element *Element) DataFieldError(fieldName string, message string) (*gobot.PassportElementErrorDataField, error) {
+	if element.credentials == nil || element.credentials.Data == nil {
+		return nil, ErrNoCredentials
+	}
+	return &gobot.PassportElementErrorDataField{
+		Type:      element.Type,
+		FieldName: fieldName,
+		DataHash:  element.credentials.Data.DataHash,
+		Message:   message,
+	}, nil
+}
+
+func (element *Element) FrontSideError(message string) (*gobot.PassportElementErrorFrontSide, error) {
+	credentials, err := element.FileCredentials(element.Encrypted.FrontSide)
+
+	if err != nil {
+		return nil, err
+	}
+	return &gobot.PassportElementErrorFrontSide{
+		Type:     element.Type,
+		FileHash: credentials.FileHash,
+		Message:  message,
+	}, nil
+}
+
+func (element *Element) ReverseSideError(message string) (*gobot.PassportElementErrorReverseSide, error) {
+	credentials, err := element.FileCredentials(element.Encrypted.ReverseSide)
+
+	if err != nil {
+		return nil, err
+	}
+	return &gobot.PassportElementErrorReverseSide{
+		Type:     element.Type,
+		FileHash: credentials.FileHash,
+		Message:  message,
+	}, nil
+}
+
+func (element *Element) SelfieError(message string) (*gobot.PassportElementErrorSelfie, error) {
+	credentials, err := element.FileCredentials(element.Encrypted.Selfie)
+
+	if err != nil {
+		return nil, err
+	}
+	return &gobot.PassportElementErrorSelfie{
+		Type:     element.Type,
+		FileHash: credentials.FileHash,
+		Message:  message,
+	}, nil
+}
+
+func (element *Element) FileError(file *gobot.PassportFile, message string) (*gobot.PassportElementErrorFile, error) {
+	credentials, err := element.FileCredentials(file)
+
+	if err != nil {
+		return nil, err
+	}
+	return &gobot.PassportElementErrorFile{
+		Type:     element.Type,
+		FileHash: credentials.FileHash,
+		Message:  message,
+	}, nil
+}
+
+// FilesError reports an error in all the files of the element.
+func (element *Element) FilesError(message string) (*gobot.PassportElementErrorFiles, error) {
+	hashes, err := element.fileHashes(element.Encrypted.Files)
+
+	if err != nil {
+		return nil, err
+	}
+	return &gobot.PassportElementErrorFiles{
+		Type:       element.Type,
+		FileHashes: hashes,
+		Message:    message,
+	}, nil
+}
+
+func (element *Element) TranslationFileError(file *gobot.PassportFile, message string) (*gobot.PassportElementErrorTranslationFile, error) {
+	credentials, err := element.FileCredentials(file)
+
+	if err != nil {
+		return nil, err
+	}
+	return &gobot.PassportElementErrorTranslationFile{
+		Type:     element.Type,
+		FileHash: credentials.FileHash,
+		Message:  message,
+	}, nil
+}
+
+// TranslationFilesError reports an error in all the translation files of the element.
+func (element *Element) TranslationFilesError(message string) (*gobot.PassportElementErrorTranslationFiles, error) {
+	hashes, err := element.fileHashes(element.Encrypted.Translation)
+
+	if err != nil {
+		return nil, err
+	}
+	return &gobot.PassportElementErrorTranslationFiles{
+		Type:       element.Type,
+		FileHashes: hashes,
+		Message:    message,
+	}, nil
+}
+
+func (element *Element) UnspecifiedError(message string) *gobot.PassportElementErrorUnspecified {
+	return &gobot.PassportElementErrorUnspecified{
+		Type:        element.Type,
+		ElementHash: element.Encrypted.Hash,
+		Message:     message,
+	}
+}
+
+func (element *Element) fileHashes(files []*gobot.PassportFile) ([]string, error) {
+	var hashes []string
+
+	for _, file := range files {
+		credentials, err := element.FileCredentials(file)
+
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, credentials.FileHash)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,196 @@
+package passport
+
+import (
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+// Passport is the decrypted PassportData shared by a user.
+type Passport struct {
+	Credentials *Credentials
+	Elements    []*Element
+}
+
+// Element is a decrypted EncryptedPassportElement. Only one of PersonalDetails, Document and Address
+// is set, depending on the type; the files are decrypted on demand with Download.
+type Element struct {
+	Type            string
+	PersonalDetails *PersonalDetails    // For "personal_details"
+	Document        *IdDocumentData     // For "passport", "driver_license", "identity_card" and "internal_passport"
+	Address         *ResidentialAddress // For "address"
+	PhoneNumber     string              // For "phone_number"
+	Email           string              // For "email"
+	Encrypted       *gobot.EncryptedPassportElement
+	credentials     *SecureValue
+}
+
+// Decrypt decrypts the credentials with the bot's private key, then the data of every element. The nonce
+// must be the one the bot passed in the authorization request, so that data shared for another request
+// is refused with ErrNonceMismatch.
+func Decrypt(key *rsa.PrivateKey, data *gobot.PassportData, nonce string) (*Passport, error) {
+	credentials, err := DecryptCredentials(key, data.Credentials, nonce)
+
+	if err != nil {
+		return nil, err
+	}
+	passport := &Passport{Credentials: credentials}
+
+	for _, encrypted := range data.Data {
+		element := &Element{
+			Type:        encrypted.Type,
+			PhoneNumber: encrypted.PhoneNumber,
+			Email:       encrypted.Email,
+			Encrypted:   encrypted,
+			credentials: credentials.SecureData[encrypted.Type],
+		}
+
+		if encrypted.Data != "" {
+			if err := element.decryptData(); err != nil {
+				return nil, err
+			}
+		}
+		passport.Elements = append(passport.Elements, element)
+	}
+	return passport, nil
+}
+
+// DecryptCredentials decrypts the credentials with the bot's private key and checks that their nonce
+// matches the one the bot passed in the authorization request.
+func DecryptCredentials(key *rsa.PrivateKey, encrypted *gobot.EncryptedCredentials, nonce string) (*Credentials, error) {
+	if encrypted == nil {
+		return nil, ErrEmptyCredentials
+	}
+	secret, err := decryptSecret(key, encrypted.Secret)
+
+	if err != nil {
+		return nil, err
+	}
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted.Data)
+
+	if err != nil {
+		return nil, err
+	}
+	hash, err := base64.StdEncoding.DecodeString(encrypted.Hash)
+
+	if err != nil {
+		return nil, err
+	}
+	data, err := decrypt(encryptedData, secret, hash)
+
+	if err != nil {
+		return nil, err
+	}
+	var credentials *Credentials
+
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return nil, err
+	} else if credentials == nil {
+		return nil, ErrEmptyCredentials
+	} else if subtle.ConstantTimeCompare([]byte(credentials.Nonce), []byte(nonce)) != 1 {
+		return nil, ErrNonceMismatch
+	}
+	return credentials, nil
+}
+
+// Element returns the element of the given type, or nil if the user didn't share it.
+func (passport *Passport) Element(elementType string) *Element {
+	for _, element := range passport.Elements {
+		if element.Type == elementType {
+			return element
+		}
+	}
+	return nil
+}
+
+func (element *Element) decryptData() error {
+	if element.credentials == nil || element.credentials.Data == nil {
+		return ErrNoCredentials
+	}
+	credentials := element.credentials.Data
+	data, err := decryptBase64(element.Encrypted.Data, credentials.Secret, credentials.DataHash)
+
+	if err != nil {
+		return err
+	}
+
+	switch element.Type {
+	case "personal_details":
+		return json.Unmarshal(data, &element.PersonalDetails)
+	case "passport", "driver_license", "identity_card", "internal_passport":
+		return json.Unmarshal(data, &element.Document)
+	case "address":
+		return json.Unmarshal(data, &element.Address)
+	}
+	return ErrUnknownDataType
+}
+
+// FileCredentials returns the credentials of one of the files of the element.
+func (element *Element) FileCredentials(file *gobot.PassportFile) (*FileCredentials, error) {
+	if element.credentials == nil {
+		return nil, ErrNoCredentials
+	}
+	encrypted := element.Encrypted
+
+	switch file {
+	case nil:
+	case encrypted.FrontSide:
+		return notNil(element.credentials.FrontSide)
+	case encrypted.ReverseSide:
+		return notNil(element.credentials.ReverseSide)
+	case encrypted.Selfie:
+		return notNil(element.credentials.Selfie)
+	}
+
+	for i, candidate := range encrypted.Files {
+		if candidate == file && i < len(element.credentials.Files) {
+			return notNil(element.credentials.Files[i])
+		}
+	}
+
+	for i, candidate := range encrypted.Translation {
+		if candidate == file && i < len(element.credentials.Translation) {
+			return notNil(element.credentials.Translation[i])
+		}
+	}
+	return nil, ErrNoCredentials
+}
+
+func notNil(credentials *FileCredentials) (*FileCredentials, error) {
+	if credentials == nil {
+		return nil, ErrNoCredentials
+	}
+	return credentials, nil
+}
+
+// Download downloads one of the files of the element, e.g. element.Encrypted.FrontSide, and decrypts it.
+func (element *Element) Download(bot *gobot.GoBot, file *gobot.PassportFile) ([]byte, error) {
+	credentials, err := element.FileCredentials(file)
+
+	if err != nil {
+		return nil, err
+	}
+	data, err := bot.GetFileContent(file.FileId)
+
+	if err != nil {
+		return nil, err
+	}
+	return DecryptFile(data, credentials)
+}
+
+func DecryptFile(data []byte, credentials *FileCredentials) ([]byte, error) {
+	secret, err := base64.StdEncoding.DecodeString(credentials.Secret)
+
+	if err != nil {
+		return nil, err
+	}
+	hash, err := base64.StdEncoding.DecodeString(credentials.FileHash)
+
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(data, secret, hash)
+}
@@ -0,0 +1,53 @@
+package passport
+
+// Credentials are the decrypted EncryptedCredentials.
+type Credentials struct {
+	SecureData map[string]*SecureValue `json:"secure_data"` // Credentials for the encrypted data, keyed by element type
+	Nonce      string                  `json:"nonce"`       // Bot-specified nonce
+}
+
+type SecureValue struct {
+	Data        *DataCredentials   `json:"data,omitempty"`         // Optional. Credentials for encrypted Telegram Passport data
+	FrontSide   *FileCredentials   `json:"front_side,omitempty"`   // Optional. Credentials for the encrypted document's front side
+	ReverseSide *FileCredentials   `json:"reverse_side,omitempty"` // Optional. Credentials for the encrypted document's reverse side
+	Selfie      *FileCredentials   `json:"selfie,omitempty"`       // Optional. Credentials for the encrypted selfie of the user with a document
+	Translation []*FileCredentials `json:"translation,omitempty"`  // Optional. Credentials for the encrypted translation of the document
+	Files       []*FileCredentials `json:"files,omitempty"`        // Optional. Credentials for the encrypted files
+}
+
+type DataCredentials struct {
+	DataHash string `json:"data_hash"` // Checksum of encrypted data
+	Secret   string `json:"secret"`    // Secret of encrypted data
+}
+
+type FileCredentials struct {
+	FileHash string `json:"file_hash"` // Checksum of encrypted file
+	Secret   string `json:"secret"`    // Secret of encrypted file
+}
+
+type PersonalDetails struct {
+	FirstName            string `json:"first_name"`                   // First Name
+	LastName             string `json:"last_name"`                    // Last Name
+	MiddleName           string `json:"middle_name,omitempty"`        // Optional. Middle Name
+	BirthDate            string `json:"birth_date"`                   // Date of birth in DD.MM.YYYY format
+	Gender               string `json:"gender"`                       // Gender, male or female
+	CountryCode          string `json:"country_code"`                 // Citizenship (ISO 3166-1 alpha-2 country code)
+	ResidenceCountryCode string `json:"residence_country_code"`       // Country of residence (ISO 3166-1 alpha-2 country code)
+	FirstNameNative      string `json:"first_name_native,omitempty"`  // Optional. First Name in the language of the user's country of residence
+	LastNameNative       string `json:"last_name_native,omitempty"`   // Optional. Last Name in the language of the user's country of residence
+	MiddleNameNative     string `json:"middle_name_native,omitempty"` // Optional. Middle Name in the language of the user's country of residence
+}
+
+type ResidentialAddress struct {
+	StreetLine1 string `json:"street_line1"`           // First line for the address
+	StreetLine2 string `json:"street_line2,omitempty"` // Optional. Second line for the address
+	City        string `json:"city"`                   // City
+	State       string `json:"state,omitempty"`        // Optional. State
+	CountryCode string `json:"country_code"`           // ISO 3166-1 alpha-2 country code
+	PostCode    string `json:"post_code"`              // Address post code
+}
+
+type IdDocumentData struct {
+	DocumentNo string `json:"document_no"`           // Document number
+	ExpiryDate string `json:"expiry_date,omitempty"` // Optional. Date of expiry, in DD.MM.YYYY format
+}
@@ -0,0 +1,80 @@
+package payments
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+type OrderStatus string
+
+const (
+	OrderCreated  OrderStatus = "created"  // The invoice was sent
+	OrderShipping OrderStatus = "shipping" // The user chose a shipping address
+	OrderCheckout OrderStatus = "checkout" // The user confirmed the payment, which was accepted
+	OrderRejected OrderStatus = "rejected" // The payment was refused for good by the pre-checkout validation
+	OrderPaid     OrderStatus = "paid"     // The payment was completed
+)
+
+// Order is an invoice sent by the shop, keyed by its InvoicePayload.
+type Order struct {
+	Payload         string
+	ProductId       string
+	ChatId          *gobot.ChatId
+	Status          OrderStatus
+	Currency        string
+	TotalAmount     int                     // Total amount of the product, without shipping and tips
+	ShippingOptions []*gobot.ShippingOption // Shipping options offered to the user, if any
+	ShippingOption  *gobot.ShippingOption   // Shipping option chosen by the user, if any
+	OrderInfo       *gobot.OrderInfo        // Information provided by the user, if any
+	Payment         *gobot.SuccessfulPayment
+	Error           string // Reason of the rejection, if rejected
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Ledger stores the orders. Put is called with a copy of the order every time it changes, Delete when the
+// invoice of a new order couldn't be sent.
+type Ledger interface {
+	Put(order *Order) error
+	Get(payload string) (*Order, bool)
+	Delete(payload string) error
+}
+
+type memoryLedger struct {
+	mutex  sync.RWMutex
+	orders map[string]*Order
+}
+
+// NewMemoryLedger returns a Ledger that keeps the orders in memory, which are lost on restart.
+func NewMemoryLedger() Ledger {
+	return &memoryLedger{orders: map[string]*Order{}}
+}
+
+func (ledger *memoryLedger) Put(order *Order) error {
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+	copied := *order
+	ledger.orders[order.Payload] = &copied
+	return nil
+}
+
+func (ledger *memoryLedger) Delete(payload string) error {
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+	delete(ledger.orders, payload)
+	return nil
+}
+
+func (ledger *memoryLedger) Get(payload string) (*Order, bool) {
+	ledger.mutex.RLock()
+	defer ledger.mutex.RUnlock()
+	order, ok := ledger.orders[payload]
+
+	if !ok {
+		return nil, false
+	}
+	copied := *order
+	return &copied, true
+}
@@ -0,0 +1,370 @@
+// Package payments wires invoices, shipping queries, pre-checkout queries and successful payments together,
+// answering the queries in time and keeping track of the orders in a ledger.
+package payments
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+// Telegram cancels the payment if a shipping or pre-checkout query isn't answered within 10 seconds.
+const defaultAnswerTimeout = 8 * time.Second
+
+var (
+	ErrUnknownProduct   = errors.New("unknown product")
+	ErrUnknownOrder     = errors.New("this order doesn't exist anymore")
+	ErrOrderNotPending  = errors.New("this order was already paid or rejected")
+	ErrAmountMismatch   = errors.New("the price of this order has changed")
+	ErrNoShipping       = errors.New("shipping is not available for this product")
+	ErrShippingOption   = errors.New("this shipping option is not available")
+	ErrAnswerTimeout    = errors.New("the order could not be processed in time, please try again")
+	ErrProductNoPrices  = errors.New("product must have at least one price")
+	ErrProductIdTooLong = errors.New("product id must be at most 95 bytes")
+)
+
+// rejection is an error of Shop.Validate that rejects the order for good.
+type rejection struct {
+	reason string
+}
+
+func (err *rejection) Error() string {
+	return err.reason
+}
+
+// Reject returns an error for Shop.Validate that refuses the payment and marks the order as rejected, e.g.
+// because the product was discontinued. Other errors refuse the payment but leave the order pending, so that
+// the user can try again.
+func Reject(reason string) error {
+	return &rejection{reason: reason}
+}
+
+// rejects reports whether the error of a pre-checkout query rejects the order for good, unlike a timeout or
+// a transient error of Shop.Validate.
+func rejects(err error) bool {
+	var rejected *rejection
+	return errors.Is(err, ErrAmountMismatch) || errors.Is(err, ErrShippingOption) || errors.As(err, &rejected)
+}
+
+type Product struct {
+	Id                  string
+	Title               string                  // Product name, 1-32 characters
+	Description         string                  // Product description, 1-255 characters
+	Currency            string                  // Three-letter ISO 4217 currency code
+	Prices              []*gobot.LabeledPrice   // Price breakdown
+	PhotoUrl            string                  // Optional. URL of the product photo
+	NeedName            bool                    // Optional. True, if the user's full name is required
+	NeedPhoneNumber     bool                    // Optional. True, if the user's phone number is required
+	NeedEmail           bool                    // Optional. True, if the user's email is required
+	NeedShippingAddress bool                    // Optional. True, if the product must be shipped
+	ShippingOptions     []*gobot.ShippingOption // Optional. Shipping options offered if Shop.ShippingOptions isn't set
+}
+
+func (product *Product) TotalAmount() int {
+	total := 0
+
+	for _, price := range product.Prices {
+		total += price.Amount
+	}
+	return total
+}
+
+// Shop sends invoices for its products and handles the rest of the payment flow.
+type Shop struct {
+	ProviderToken   string
+	Ledger          Ledger
+	ShippingOptions func(order *Order, address *gobot.ShippingAddress) ([]*gobot.ShippingOption, error) // Optional. Computes the shipping options for an address, an error is shown to the user
+	Validate        func(order *Order, query *gobot.PreCheckoutQuery) error                             // Optional. Last check before accepting a payment, e.g. for availability, an error is shown to the user and rejects the order only if made with Reject
+	OnPayment       func(bot *gobot.GoBot, order *Order)                                                // Optional. Called when a payment is completed
+	OnError         func(order *Order, err error)                                                       // Optional. Called when an answer or the ledger fails
+	AnswerTimeout   time.Duration                                                                       // Time the callbacks have to answer a query before it's refused, defaults to 8 seconds
+	mutex           sync.RWMutex
+	products        map[string]*Product
+}
+
+func New(providerToken string) *Shop {
+	return &Shop{
+		ProviderToken: providerToken,
+		Ledger:        NewMemoryLedger(),
+		AnswerTimeout: defaultAnswerTimeout,
+		products:      map[string]*Product{},
+	}
+}
+
+func (shop *Shop) AddProduct(product *Product) error {
+	if len(product.Prices) == 0 {
+		return ErrProductNoPrices
+	}
+
+	if len(product.Id) > 95 {
+		return ErrProductIdTooLong
+	}
+	shop.mutex.Lock()
+	shop.products[product.Id] = product
+	shop.mutex.Unlock()
+	return nil
+}
+
+func (shop *Shop) Product(id string) (*Product, bool) {
+	shop.mutex.RLock()
+	defer shop.mutex.RUnlock()
+	product, ok := shop.products[id]
+	return product, ok
+}
+
+// Register adds the handlers for shipping queries, pre-checkout queries and successful payments.
+func (shop *Shop) Register(bot *gobot.GoBot) {
+	bot.AddHandler(&gobot.ShippingQuery{}, shop.handleShippingQuery)
+	bot.AddHandler(&gobot.PreCheckoutQuery{}, shop.handlePreCheckoutQuery)
+	bot.AddHandler(&gobot.Message{}, shop.handleMessage)
+}
+
+// SendInvoice creates an order for the product and sends its invoice to the chat.
+func (shop *Shop) SendInvoice(bot *gobot.GoBot, chatId *gobot.ChatId, productId string) (*Order, *gobot.Message, error) {
+	product, ok := shop.Product(productId)
+
+	if !ok {
+		return nil, nil, ErrUnknownProduct
+	}
+	nonce := make([]byte, 16)
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	order := &Order{
+		Payload:     product.Id + ":" + hex.EncodeToString(nonce),
+		ProductId:   product.Id,
+		ChatId:      chatId,
+		Status:      OrderCreated,
+		Currency:    product.Currency,
+		TotalAmount: product.TotalAmount(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := shop.put(order); err != nil {
+		return nil, nil, err
+	}
+	message, err := bot.SendInvoice(gobot.SendInvoiceParams{
+		ChatId:              chatId,
+		Title:               product.Title,
+		Description:         product.Description,
+		Payload:             order.Payload,
+		ProviderToken:       shop.ProviderToken,
+		Currency:            product.Currency,
+		Prices:              product.Prices,
+		PhotoUrl:            product.PhotoUrl,
+		NeedName:            product.NeedName,
+		NeedPhoneNumber:     product.NeedPhoneNumber,
+		NeedEmail:           product.NeedEmail,
+		NeedShippingAddress: product.NeedShippingAddress,
+		IsFlexible:          product.NeedShippingAddress,
+	})
+
+	if err != nil {
+		// The invoice can't be paid, so the order is rolled back
+		if deleteErr := shop.Ledger.Delete(order.Payload); deleteErr != nil && shop.OnError != nil {
+			shop.OnError(order, deleteErr)
+		}
+		return nil, nil, err
+	}
+	return order, message, nil
+}
+
+// answerInTime runs the callback, returning ErrAnswerTimeout if it doesn't return before the timeout. The
+// callback keeps running after a timeout, so it mustn't change what is used afterwards.
+func (shop *Shop) answerInTime(callback func() error) error {
+	timeout := shop.AnswerTimeout
+
+	if timeout <= 0 {
+		timeout = defaultAnswerTimeout
+	}
+	result := make(chan error, 1)
+
+	go func() {
+		result <- callback()
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(timeout):
+		return ErrAnswerTimeout
+	}
+}
+
+func (shop *Shop) update(order *Order) {
+	order.UpdatedAt = time.Now()
+
+	if err := shop.put(order); err != nil && shop.OnError != nil {
+		shop.OnError(order, err)
+	}
+}
+
+// put stores a copy of the order, so that the ledger doesn't see the changes made to it afterwards.
+func (shop *Shop) put(order *Order) error {
+	copied := *order
+	return shop.Ledger.Put(&copied)
+}
+
+func (shop *Shop) handleShippingQuery(bot *gobot.GoBot, update *gobot.Update) {
+	query := update.ShippingQuery
+	order, ok := shop.Ledger.Get(query.InvoicePayload)
+	var options []*gobot.ShippingOption
+	err := shop.answerInTime(func() error {
+		if !ok {
+			return ErrUnknownOrder
+		}
+
+		if order.Status != OrderCreated && order.Status != OrderShipping {
+			return ErrOrderNotPending
+		}
+
+		if shop.ShippingOptions != nil {
+			var err error
+			options, err = shop.ShippingOptions(order, query.ShippingAddress)
+			return err
+		}
+		product, ok := shop.Product(order.ProductId)
+
+		if !ok {
+			return ErrUnknownProduct
+		}
+		options = product.ShippingOptions
+		return nil
+	})
+
+	if err == nil && len(options) == 0 {
+		err = ErrNoShipping
+	}
+	params := gobot.AnswerShippingQueryParams{
+		ShippingQueryId: query.Id,
+		Ok:              err == nil,
+	}
+
+	if err != nil {
+		params.ErrorMessage = err.Error()
+	} else {
+		params.ShippingOptions = options
+	}
+
+	if _, err := bot.AnswerShippingQuery(params); err != nil && shop.OnError != nil {
+		shop.OnError(order, err)
+	}
+
+	if ok && params.Ok {
+		order.Status = OrderShipping
+		order.ShippingOptions = options
+		shop.update(order)
+	}
+}
+
+func (shop *Shop) handlePreCheckoutQuery(bot *gobot.GoBot, update *gobot.Update) {
+	query := update.PreCheckoutQuery
+	stored, ok := shop.Ledger.Get(query.InvoicePayload)
+	var checked Order
+
+	if ok {
+		// The checks work on a copy, which they may still be changing after a timeout
+		checked = *stored
+	}
+	err := shop.answerInTime(func() error {
+		if !ok {
+			return ErrUnknownOrder
+		}
+		order := &checked
+
+		// Telegram sends the query again if the payment provider fails after the order was accepted
+		if order.Status != OrderCreated && order.Status != OrderShipping && order.Status != OrderCheckout {
+			return ErrOrderNotPending
+		}
+		total := order.TotalAmount
+
+		if query.ShippingOptionId != "" {
+			option := findShippingOption(order.ShippingOptions, query.ShippingOptionId)
+
+			if option == nil {
+				return ErrShippingOption
+			}
+
+			for _, price := range option.Prices {
+				total += price.Amount
+			}
+			order.ShippingOption = option
+		}
+
+		// The total can only be higher than expected because of tips
+		if query.Currency != order.Currency || query.TotalAmount < total {
+			return ErrAmountMismatch
+		}
+		order.OrderInfo = query.OrderInfo
+
+		if shop.Validate != nil {
+			return shop.Validate(order, query)
+		}
+		return nil
+	})
+	params := gobot.AnswerPreCheckoutQueryParams{
+		PreCheckoutQueryId: query.Id,
+		Ok:                 err == nil,
+	}
+
+	if err != nil {
+		params.ErrorMessage = err.Error()
+	}
+
+	if _, err := bot.AnswerPreCheckoutQuery(params); err != nil && shop.OnError != nil {
+		shop.OnError(stored, err)
+	}
+
+	if !ok {
+		return
+	} else if params.Ok {
+		checked.Status = OrderCheckout
+		shop.update(&checked)
+	} else if rejects(err) {
+		stored.Status = OrderRejected
+		stored.Error = params.ErrorMessage
+		shop.update(stored)
+	}
+}
+
+func (shop *Shop) handleMessage(bot *gobot.GoBot, update *gobot.Update) {
+	if update.Message == nil || update.Message.SuccessfulPayment == nil {
+		return
+	}
+	payment := update.Message.SuccessfulPayment
+	order, ok := shop.Ledger.Get(payment.InvoicePayload)
+
+	if !ok {
+		if shop.OnError != nil {
+			shop.OnError(&Order{Payload: payment.InvoicePayload, Payment: payment}, ErrUnknownOrder)
+		}
+		return
+	}
+	order.Status = OrderPaid
+	order.Payment = payment
+
+	if payment.OrderInfo != nil {
+		order.OrderInfo = payment.OrderInfo
+	}
+	shop.update(order)
+
+	if shop.OnPayment != nil {
+		shop.OnPayment(bot, order)
+	}
+}
+
+func findShippingOption(options []*gobot.ShippingOption, id string) *gobot.ShippingOption {
+	for _, option := range options {
+		if option.Id == id {
+			return option
+		}
+	}
+	return nil
+}
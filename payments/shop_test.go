@@ -0,0 +1,250 @@
+package payments
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+// fakeApi answers the requests of the shop and records the answers to the queries.
+type fakeApi struct {
+	mutex       sync.Mutex
+	failInvoice bool
+	shipping    []gobot.AnswerShippingQueryParams
+	preCheckout []gobot.AnswerPreCheckoutQueryParams
+}
+
+func (api *fakeApi) Do(method string, params interface{}) (json.RawMessage, error) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	switch method {
+	case "sendInvoice":
+		if api.failInvoice {
+			return nil, &gobot.Error{ErrorCode: 400, Description: "Bad Request: CURRENCY_INVALID"}
+		}
+		return json.Marshal(&gobot.Message{MessageId: 1, Chat: &gobot.Chat{Id: 1}})
+	case "answerShippingQuery":
+		api.shipping = append(api.shipping, params.(gobot.AnswerShippingQueryParams))
+	case "answerPreCheckoutQuery":
+		api.preCheckout = append(api.preCheckout, params.(gobot.AnswerPreCheckoutQueryParams))
+	}
+	return json.RawMessage("true"), nil
+}
+
+func (api *fakeApi) lastPreCheckout(t *testing.T) gobot.AnswerPreCheckoutQueryParams {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	if len(api.preCheckout) == 0 {
+		t.Fatal("expected the pre-checkout query to be answered")
+	}
+	return api.preCheckout[len(api.preCheckout)-1]
+}
+
+var testShippingOption = &gobot.ShippingOption{
+	Id:     "standard",
+	Title:  "Standard",
+	Prices: []*gobot.LabeledPrice{{Label: "Shipping", Amount: 500}},
+}
+
+func newTestShop(t *testing.T) (*Shop, *gobot.GoBot, *fakeApi) {
+	shop := New("provider")
+	api := &fakeApi{}
+	bot := gobot.Init("123:token")
+	bot.SetLogger(nil)
+	bot.SetTransport(api)
+	shop.Register(bot)
+	err := shop.AddProduct(&Product{
+		Id:                  "book",
+		Title:               "Book",
+		Description:         "A book",
+		Currency:            "EUR",
+		Prices:              []*gobot.LabeledPrice{{Label: "Book", Amount: 1000}},
+		NeedShippingAddress: true,
+		ShippingOptions:     []*gobot.ShippingOption{testShippingOption},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	return shop, bot, api
+}
+
+func sendTestInvoice(t *testing.T, shop *Shop, bot *gobot.GoBot) *Order {
+	order, _, err := shop.SendInvoice(bot, gobot.NewChatId(1), "book")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	return order
+}
+
+func preCheckout(bot *gobot.GoBot, order *Order, total int, shippingOptionId string) {
+	bot.HandleUpdate(&gobot.Update{PreCheckoutQuery: &gobot.PreCheckoutQuery{
+		Id:               "query",
+		From:             &gobot.User{Id: 1},
+		Currency:         "EUR",
+		TotalAmount:      total,
+		InvoicePayload:   order.Payload,
+		ShippingOptionId: shippingOptionId,
+	}})
+}
+
+func expectStatus(t *testing.T, shop *Shop, order *Order, status OrderStatus) *Order {
+	stored, ok := shop.Ledger.Get(order.Payload)
+
+	if !ok {
+		t.Fatalf("expected the order to be in the ledger")
+	} else if stored.Status != status {
+		t.Fatalf("expected the order to be %s, got %s", status, stored.Status)
+	}
+	return stored
+}
+
+func TestOrderLifecycle(t *testing.T) {
+	shop, bot, api := newTestShop(t)
+	var paid *Order
+	shop.OnPayment = func(bot *gobot.GoBot, order *Order) {
+		paid = order
+	}
+	order := sendTestInvoice(t, shop, bot)
+	expectStatus(t, shop, order, OrderCreated)
+	bot.HandleUpdate(&gobot.Update{ShippingQuery: &gobot.ShippingQuery{
+		Id:              "shipping",
+		From:            &gobot.User{Id: 1},
+		InvoicePayload:  order.Payload,
+		ShippingAddress: &gobot.ShippingAddress{CountryCode: "IT"},
+	}})
+
+	if len(api.shipping) != 1 || !api.shipping[0].Ok || len(api.shipping[0].ShippingOptions) != 1 {
+		t.Fatalf("expected the shipping options, got %+v", api.shipping)
+	}
+	expectStatus(t, shop, order, OrderShipping)
+	preCheckout(bot, order, 1500, "standard")
+
+	if answer := api.lastPreCheckout(t); !answer.Ok {
+		t.Fatalf("expected the payment to be accepted, got %q", answer.ErrorMessage)
+	}
+	stored := expectStatus(t, shop, order, OrderCheckout)
+
+	if stored.ShippingOption == nil || stored.ShippingOption.Id != "standard" {
+		t.Errorf("expected the shipping option to be stored, got %+v", stored.ShippingOption)
+	}
+	// The provider failed and Telegram asks again
+	preCheckout(bot, order, 1500, "standard")
+
+	if answer := api.lastPreCheckout(t); !answer.Ok {
+		t.Fatalf("expected the payment to be accepted again, got %q", answer.ErrorMessage)
+	}
+	bot.HandleUpdate(&gobot.Update{Message: &gobot.Message{
+		MessageId:         2,
+		Chat:              &gobot.Chat{Id: 1},
+		SuccessfulPayment: &gobot.SuccessfulPayment{Currency: "EUR", TotalAmount: 1500, InvoicePayload: order.Payload},
+	}})
+	expectStatus(t, shop, order, OrderPaid)
+
+	if paid == nil || paid.Payment == nil {
+		t.Fatal("expected OnPayment to be called with the payment")
+	}
+	preCheckout(bot, order, 1500, "standard")
+
+	if answer := api.lastPreCheckout(t); answer.Ok || answer.ErrorMessage != ErrOrderNotPending.Error() {
+		t.Errorf("expected a paid order to be refused, got %+v", answer)
+	}
+}
+
+func TestPreCheckoutRejections(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		option   string
+		validate func(order *Order, query *gobot.PreCheckoutQuery) error
+		status   OrderStatus
+	}{
+		{"amount mismatch", 1000, "standard", nil, OrderRejected},
+		{"unknown shipping option", 1500, "express", nil, OrderRejected},
+		{"tip", 1700, "standard", nil, OrderCheckout},
+		{"rejected by validate", 1500, "standard", func(*Order, *gobot.PreCheckoutQuery) error {
+			return Reject("out of stock")
+		}, OrderRejected},
+		{"transient error of validate", 1500, "standard", func(*Order, *gobot.PreCheckoutQuery) error {
+			return errors.New("database unavailable")
+		}, OrderShipping},
+	}
+
+	for _, test := range tests {
+		shop, bot, api := newTestShop(t)
+		shop.Validate = test.validate
+		order := sendTestInvoice(t, shop, bot)
+		order.Status = OrderShipping
+		order.ShippingOptions = []*gobot.ShippingOption{testShippingOption}
+
+		if err := shop.Ledger.Put(order); err != nil {
+			t.Fatal(err)
+		}
+		preCheckout(bot, order, test.total, test.option)
+		answer := api.lastPreCheckout(t)
+
+		if answer.Ok != (test.status == OrderCheckout) {
+			t.Errorf("%s: unexpected answer %+v", test.name, answer)
+		}
+
+		if stored, _ := shop.Ledger.Get(order.Payload); stored.Status != test.status {
+			t.Errorf("%s: expected the order to be %s, got %s", test.name, test.status, stored.Status)
+		}
+	}
+}
+
+func TestPreCheckoutTimeout(t *testing.T) {
+	shop, bot, api := newTestShop(t)
+	shop.AnswerTimeout = 10 * time.Millisecond
+	release := make(chan struct{})
+	defer close(release)
+	shop.Validate = func(order *Order, query *gobot.PreCheckoutQuery) error {
+		<-release
+		return nil
+	}
+	order := sendTestInvoice(t, shop, bot)
+	preCheckout(bot, order, 1000, "")
+
+	if answer := api.lastPreCheckout(t); answer.Ok || answer.ErrorMessage != ErrAnswerTimeout.Error() {
+		t.Errorf("expected a timeout, got %+v", answer)
+	}
+	expectStatus(t, shop, order, OrderCreated)
+}
+
+func TestPreCheckoutUnknownOrder(t *testing.T) {
+	shop, bot, api := newTestShop(t)
+	preCheckout(bot, &Order{Payload: "book:missing"}, 1000, "")
+
+	if answer := api.lastPreCheckout(t); answer.Ok || answer.ErrorMessage != ErrUnknownOrder.Error() {
+		t.Errorf("expected an unknown order, got %+v", answer)
+	}
+
+	if _, ok := shop.Ledger.Get("book:missing"); ok {
+		t.Error("expected no order to be stored")
+	}
+}
+
+func TestSendInvoiceFailureRollsBack(t *testing.T) {
+	shop, bot, api := newTestShop(t)
+	api.failInvoice = true
+	ledger := shop.Ledger.(*memoryLedger)
+
+	if _, _, err := shop.SendInvoice(bot, gobot.NewChatId(1), "book"); err == nil {
+		t.Fatal("expected the error of sendInvoice")
+	}
+
+	if len(ledger.orders) != 0 {
+		t.Errorf("expected the order to be rolled back, got %d orders", len(ledger.orders))
+	}
+
+	if _, _, err := shop.SendInvoice(bot, gobot.NewChatId(1), "missing"); !errors.Is(err, ErrUnknownProduct) {
+		t.Errorf("expected ErrUnknownProduct, got %v", err)
+	}
+}
@@ -0,0 +1,261 @@
+// Package prometheus exports the metrics of a bot in the Prometheus text format:
+//
+//	gobot_request_duration_seconds   histogram of the requests, by bot and method
+//	gobot_request_errors_total       failed requests, by bot, method and error code
+//	gobot_request_retries_total      requests retried because of flood control, by bot and method
+//	gobot_update_duration_seconds    histogram of the handled updates, by bot and type
+//	gobot_update_panics_total        updates whose handlers panicked, by bot and type
+//	gobot_update_queue_depth         updates received and not handled yet, by bot
+//
+// The numbers of requests and updates are the _count series of the histograms. The bot label is the name
+// of the bot in its gobot.Manager, empty for a bot that isn't managed.
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+// DefaultBuckets are the upper bounds in seconds of the histograms, up to the long polling of getUpdates.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Exporter collects the metrics of the bots it's set to with SetMetrics and serves them over HTTP. The
+// maps are keyed by the labels of the series, already formatted.
+type Exporter struct {
+	buckets       []float64
+	mutex         sync.Mutex
+	requests      map[string]*histogram
+	requestErrors map[string]map[string]int
+	retries       map[string]int
+	updates       map[string]*histogram
+	panics        map[string]int
+	bots          []*gobot.GoBot // Bots whose queue depth is read when writing the metrics
+}
+
+type histogram struct {
+	counts []int // Cumulative count of each bucket
+	sum    float64
+	count  int
+}
+
+// NewExporter returns an exporter whose histograms have the given buckets, DefaultBuckets if none.
+func NewExporter(buckets ...float64) *Exporter {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &Exporter{
+		buckets:       buckets,
+		requests:      map[string]*histogram{},
+		requestErrors: map[string]map[string]int{},
+		retries:       map[string]int{},
+		updates:       map[string]*histogram{},
+		panics:        map[string]int{},
+	}
+}
+
+func (exporter *Exporter) ObserveRequest(bot string, method string, duration time.Duration, err error) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	labels := botLabels(bot, "method", method)
+	exporter.observe(exporter.requests, labels, duration)
+
+	if err == nil {
+		return
+	}
+
+	if exporter.requestErrors[labels] == nil {
+		exporter.requestErrors[labels] = map[string]int{}
+	}
+	exporter.requestErrors[labels][errorCode(err)]++
+}
+
+func (exporter *Exporter) ObserveRetry(bot string, method string) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	exporter.retries[botLabels(bot, "method", method)]++
+}
+
+func (exporter *Exporter) ObserveUpdate(bot string, updateType string, duration time.Duration, panicked bool) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	labels := botLabels(bot, "type", updateType)
+	exporter.observe(exporter.updates, labels, duration)
+
+	if panicked {
+		exporter.panics[labels]++
+	}
+}
+
+// WatchQueue makes the exporter read the queue depth of the bot every time it writes the metrics.
+func (exporter *Exporter) WatchQueue(bot *gobot.GoBot) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	for _, watched := range exporter.bots {
+		if watched == bot {
+			return
+		}
+	}
+	exporter.bots = append(exporter.bots, bot)
+}
+
+// UnwatchQueue stops reading the queue depth of the bot, e.g. once it has been removed from its manager.
+func (exporter *Exporter) UnwatchQueue(bot *gobot.GoBot) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	for i, watched := range exporter.bots {
+		if watched == bot {
+			exporter.bots = append(exporter.bots[:i], exporter.bots[i+1:]...)
+			return
+		}
+	}
+}
+
+// botLabels formats the labels of a series of the bot.
+func botLabels(bot string, label string, value string) string {
+	return "bot=" + quote(bot) + "," + label + "=" + quote(value)
+}
+
+func (exporter *Exporter) observe(histograms map[string]*histogram, labels string, duration time.Duration) {
+	entry, ok := histograms[labels]
+
+	if !ok {
+		entry = &histogram{counts: make([]int, len(exporter.buckets))}
+		histograms[labels] = entry
+	}
+	seconds := duration.Seconds()
+
+	for i, bound := range exporter.buckets {
+		if seconds <= bound {
+			entry.counts[i]++
+		}
+	}
+	entry.sum += seconds
+	entry.count++
+}
+
+// errorCode returns the label of the error: the error code of the Bot API, "validation" for invalid params
+// and "other" for the rest, e.g. network errors.
+func errorCode(err error) string {
+	var apiError *gobot.Error
+	var validationError *gobot.ValidationError
+
+	if errors.As(err, &apiError) {
+		return strconv.Itoa(apiError.ErrorCode)
+	} else if errors.As(err, &validationError) {
+		return "validation"
+	}
+	return "other"
+}
+
+// WriteTo writes the metrics in the Prometheus text format.
+func (exporter *Exporter) WriteTo(w io.Writer) (int64, error) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	var builder strings.Builder
+	exporter.writeHistograms(&builder, "gobot_request_duration_seconds", "Duration of the requests to the Bot API.", exporter.requests)
+	writeHeader(&builder, "gobot_request_errors_total", "Requests to the Bot API that failed.", "counter")
+
+	for _, labels := range sortedKeys(exporter.requestErrors) {
+		codes := exporter.requestErrors[labels]
+
+		for _, code := range sortedKeys(codes) {
+			fmt.Fprintf(&builder, "gobot_request_errors_total{%s,code=%s} %d\n", labels, quote(code), codes[code])
+		}
+	}
+	writeSeries(&builder, "gobot_request_retries_total", "Requests retried because of flood control.", "counter", exporter.retries)
+	exporter.writeHistograms(&builder, "gobot_update_duration_seconds", "Duration of the handling of the updates.", exporter.updates)
+	writeSeries(&builder, "gobot_update_panics_total", "Updates whose handlers panicked.", "counter", exporter.panics)
+	writeSeries(&builder, "gobot_update_queue_depth", "Updates received and not handled yet.", "gauge", exporter.queueDepths())
+	written, err := io.WriteString(w, builder.String())
+	return int64(written), err
+}
+
+// queueDepths reads the queue depth of the watched bots, summing those with the same name.
+func (exporter *Exporter) queueDepths() map[string]int {
+	depths := map[string]int{}
+
+	for _, bot := range exporter.bots {
+		depths["bot="+quote(bot.Name())] += bot.QueueDepth()
+	}
+	return depths
+}
+
+func (exporter *Exporter) writeHistograms(builder *strings.Builder, name string, help string, histograms map[string]*histogram) {
+	writeHeader(builder, name, help, "histogram")
+
+	for _, labels := range sortedKeys(histograms) {
+		entry := histograms[labels]
+
+		for i, bound := range exporter.buckets {
+			fmt.Fprintf(builder, "%s_bucket{%s,le=\"%s\"} %d\n", name, labels, strconv.FormatFloat(bound, 'f', -1, 64), entry.counts[i])
+		}
+		fmt.Fprintf(builder, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, entry.count)
+		fmt.Fprintf(builder, "%s_sum{%s} %s\n", name, labels, strconv.FormatFloat(entry.sum, 'f', -1, 64))
+		fmt.Fprintf(builder, "%s_count{%s} %d\n", name, labels, entry.count)
+	}
+}
+
+func writeSeries(builder *strings.Builder, name string, help string, metricType string, values map[string]int) {
+	writeHeader(builder, name, help, metricType)
+
+	for _, labels := range sortedKeys(values) {
+		fmt.Fprintf(builder, "%s{%s} %d\n", name, labels, values[labels])
+	}
+}
+
+func writeHeader(builder *strings.Builder, name string, help string, metricType string) {
+	fmt.Fprintf(builder, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func quote(value string) string {
+	return `"` + labelEscaper.Replace(value) + `"`
+}
+
+// sortedKeys returns the keys of a map with string keys, sorted so that the output is stable.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+
+	switch m := m.(type) {
+	case map[string]*histogram:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]map[string]int:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]int:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (exporter *Exporter) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = exporter.WriteTo(w)
+}
+
+// ListenAndServe serves the metrics on /metrics of the address, e.g. "localhost:9090".
+func (exporter *Exporter) ListenAndServe(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", exporter)
+	return http.ListenAndServe(addr, mux)
+}
@@ -0,0 +1,90 @@
+package prometheus
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattiabrandon/gobot"
+)
+
+func metrics(t *testing.T, exporter *Exporter) string {
+	var builder strings.Builder
+
+	if _, err := exporter.WriteTo(&builder); err != nil {
+		t.Fatal(err)
+	}
+	return builder.String()
+}
+
+func expectLines(t *testing.T, output string, lines ...string) {
+	for _, line := range lines {
+		if !strings.Contains(output, line+"\n") {
+			t.Errorf("expected the line %q in:\n%s", line, output)
+		}
+	}
+}
+
+func TestExporterHistograms(t *testing.T) {
+	buckets := []float64{1, 0.1}
+	exporter := NewExporter(buckets...)
+	buckets[0] = 100
+	exporter.ObserveRequest("", "sendMessage", 50*time.Millisecond, nil)
+	exporter.ObserveRequest("", "sendMessage", 500*time.Millisecond, &gobot.Error{ErrorCode: 400})
+	exporter.ObserveRequest("", "sendMessage", 2*time.Second, errors.New("timeout"))
+	exporter.ObserveRetry("", "sendMessage")
+	exporter.ObserveUpdate("a", "message", time.Millisecond, true)
+	expectLines(t, metrics(t, exporter),
+		`gobot_request_duration_seconds_bucket{bot="",method="sendMessage",le="0.1"} 1`,
+		`gobot_request_duration_seconds_bucket{bot="",method="sendMessage",le="1"} 2`,
+		`gobot_request_duration_seconds_bucket{bot="",method="sendMessage",le="+Inf"} 3`,
+		`gobot_request_duration_seconds_sum{bot="",method="sendMessage"} 2.55`,
+		`gobot_request_duration_seconds_count{bot="",method="sendMessage"} 3`,
+		`gobot_request_errors_total{bot="",method="sendMessage",code="400"} 1`,
+		`gobot_request_errors_total{bot="",method="sendMessage",code="other"} 1`,
+		`gobot_request_retries_total{bot="",method="sendMessage"} 1`,
+		`gobot_update_duration_seconds_count{bot="a",type="message"} 1`,
+		`gobot_update_panics_total{bot="a",type="message"} 1`,
+	)
+}
+
+func TestExporterValidationErrors(t *testing.T) {
+	exporter := NewExporter()
+	bot := gobot.Init("123:token")
+	bot.SetLogger(nil)
+	bot.Validate = true
+	bot.SetTransport(gobot.TransportFunc(func(method string, params interface{}) (json.RawMessage, error) {
+		t.Errorf("expected the invalid request not to be sent")
+		return nil, nil
+	}))
+	bot.SetMetrics(exporter)
+
+	if _, err := bot.SendMessage(gobot.SendMessageParams{ChatId: gobot.NewChatId(1)}); err == nil {
+		t.Fatal("expected a validation error")
+	}
+	expectLines(t, metrics(t, exporter),
+		`gobot_request_errors_total{bot="",method="sendMessage",code="validation"} 1`,
+		`gobot_request_duration_seconds_count{bot="",method="sendMessage"} 1`,
+	)
+}
+
+func TestExporterQueueDepth(t *testing.T) {
+	exporter := NewExporter()
+	manager := gobot.NewManager()
+	bot := gobot.Init("123:token")
+	bot.SetLogger(nil)
+
+	if err := manager.Add("a", bot); err != nil {
+		t.Fatal(err)
+	}
+	bot.SetMetrics(exporter)
+	bot.SetMetrics(exporter)
+	expectLines(t, metrics(t, exporter), `gobot_update_queue_depth{bot="a"} 0`)
+	bot.SetMetrics(nil)
+
+	if output := metrics(t, exporter); strings.Contains(output, `gobot_update_queue_depth{`) {
+		t.Errorf("expected the bot not to be watched anymore:\n%s", output)
+	}
+}
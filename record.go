@@ -0,0 +1,216 @@
+package gobot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+var ErrReplayNoResponse = errors.New("no recorded response left for the method")
+
+// Record is a line of a recording: either an incoming update or a request made by the bot, with its outcome.
+type Record struct {
+	Time        time.Time           `json:"time"`
+	Update      *Update             `json:"update,omitempty"`
+	Method      string              `json:"method,omitempty"`
+	Params      json.RawMessage     `json:"params,omitempty"`
+	Result      json.RawMessage     `json:"result,omitempty"`
+	ErrorCode   int                 `json:"error_code,omitempty"`
+	Description string              `json:"description,omitempty"` // Description of the error, if the request failed
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`
+}
+
+// Recorder writes the updates received by the bot and, optionally, the requests it makes as JSON lines.
+type Recorder struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+	err     error
+}
+
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{encoder: json.NewEncoder(w)}
+}
+
+// Record starts recording the updates received by the bot to w and, if requests is true, the requests
+// it makes with their results. It must be called before the bot starts receiving updates.
+func (bot *GoBot) Record(w io.Writer, requests bool) *Recorder {
+	recorder := NewRecorder(w)
+	bot.Use(recorder.Middleware())
+
+	if requests {
+		bot.SetTransport(recorder.Transport(bot.Transport()))
+	}
+	return recorder
+}
+
+// Err returns the first error met writing the records, after which nothing else is written.
+func (recorder *Recorder) Err() error {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return recorder.err
+}
+
+func (recorder *Recorder) write(record *Record) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	if recorder.err == nil {
+		recorder.err = recorder.encoder.Encode(record)
+	}
+}
+
+// Middleware records every update before passing it on.
+func (recorder *Recorder) Middleware() Middleware {
+	return func(next UpdateHandler) UpdateHandler {
+		return func(bot *GoBot, update *Update) {
+			recorder.write(&Record{Time: time.Now(), Update: update})
+			next(bot, update)
+		}
+	}
+}
+
+// Transport records every request made through next, with its result or error, except getUpdates,
+// whose updates are recorded by Middleware.
+func (recorder *Recorder) Transport(next Transport) Transport {
+	return TransportFunc(func(method string, params interface{}) (json.RawMessage, error) {
+		if method == "getUpdates" {
+			return next.Do(method, params)
+		}
+		record := &Record{Time: time.Now(), Method: method}
+
+		if params != nil {
+			record.Params, _ = json.Marshal(params)
+		}
+		result, err := next.Do(method, params)
+		record.Result = result
+
+		if err != nil {
+			record.Description = err.Error()
+			var apiErr *Error
+
+			if errors.As(err, &apiErr) {
+				record.ErrorCode = apiErr.ErrorCode
+				record.Parameters = apiErr.Parameters
+			}
+		}
+		recorder.write(record)
+		return result, err
+	})
+}
+
+// Replay feeds the updates of a recording to a bot, e.g. to reproduce a bug. The bot should use a
+// transport that doesn't reach Telegram, like the one returned by Transport or a fake server.
+type Replay struct {
+	Records []*Record
+	Speed   float64               // Optional. How many times faster than recorded the updates are fed, 0 to feed them without waiting
+	MaxWait time.Duration         // Optional. Maximum wait between two updates, to skip long idle periods
+	Sleep   func(d time.Duration) // Optional. Waits between the updates instead of time.Sleep
+	Clock   *FakeClock            // Optional. Clock set on the bot while the updates are fed, starting at the first record if nil
+}
+
+// NewReplay reads a recording written by a Recorder.
+func NewReplay(r io.Reader) (*Replay, error) {
+	replay := &Replay{Speed: 1, Sleep: time.Sleep}
+	decoder := json.NewDecoder(r)
+
+	for {
+		var record *Record
+
+		if err := decoder.Decode(&record); err == io.EOF {
+			return replay, nil
+		} else if err != nil {
+			return nil, err
+		}
+		replay.Records = append(replay.Records, record)
+	}
+}
+
+// Run calls HandleUpdate with every recorded update, one at a time, waiting between them as much as they
+// were apart when recorded, divided by Speed. The clock of the bot is replaced by Clock, which is advanced
+// by the recorded time between the updates whatever the Speed, so that the timers of the bot, such as the
+// OnMediaGroup window and the InlineHandler debounce, group and drop the updates as they did when recorded.
+// The timers left are fired after the last update, then the previous clock of the bot is restored. The
+// payments answer timeout is Telegram's deadline, so it still runs in real time.
+func (replay *Replay) Run(bot *GoBot) {
+	var last time.Time
+	clock := replay.Clock
+
+	if clock == nil {
+		clock = NewFakeClock(time.Now())
+
+		if len(replay.Records) > 0 {
+			clock = NewFakeClock(replay.Records[0].Time)
+		}
+	}
+	previous := bot.Clock()
+	bot.SetClock(clock)
+	defer bot.SetClock(previous)
+
+	for _, record := range replay.Records {
+		if record.Update == nil {
+			continue
+		}
+
+		if !last.IsZero() {
+			replay.wait(record.Time.Sub(last))
+			clock.Advance(record.Time.Sub(last))
+		}
+		last = record.Time
+		bot.HandleUpdate(record.Update)
+	}
+	clock.Flush()
+}
+
+// wait waits the time recorded between two updates, scaled by Speed and capped by MaxWait.
+func (replay *Replay) wait(recorded time.Duration) {
+	if replay.Speed <= 0 {
+		return
+	}
+	wait := time.Duration(float64(recorded) / replay.Speed)
+
+	if replay.MaxWait > 0 && wait > replay.MaxWait {
+		wait = replay.MaxWait
+	}
+
+	if wait > 0 && replay.Sleep != nil {
+		replay.Sleep(wait)
+	} else if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// Transport returns a transport that answers every request with the next recorded response to the same
+// method, or ErrReplayNoResponse when they run out.
+func (replay *Replay) Transport() Transport {
+	var mutex sync.Mutex
+	responses := map[string][]*Record{}
+
+	for _, record := range replay.Records {
+		if record.Method != "" {
+			responses[record.Method] = append(responses[record.Method], record)
+		}
+	}
+	return TransportFunc(func(method string, params interface{}) (json.RawMessage, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		pending := responses[method]
+
+		if len(pending) == 0 {
+			return nil, fmt.Errorf("%s: %w", method, ErrReplayNoResponse)
+		}
+		responses[method] = pending[1:]
+		record := pending[0]
+
+		if record.Description != "" && record.Result == nil {
+			return nil, &Error{
+				Description: record.Description,
+				ErrorCode:   record.ErrorCode,
+				Parameters:  record.Parameters,
+			}
+		}
+		return record.Result, nil
+	})
+}
@@ -0,0 +1,40 @@
+package gobot
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type Body struct {
+	Ok          bool                `json:"ok"`
+	Description string              `json:"description,omitempty"`
+	ErrorCode   int                 `json:"error_code,omitempty"`
+	Result      json.RawMessage     `json:"result,omitempty"`
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`
+}
+
+type Error struct {
+	Description string
+	ErrorCode   int
+	Parameters  *ResponseParameters
+}
+
+func (err *Error) Error() string {
+	return err.Description
+}
+
+type EditResult struct {
+	Message *Message // The edited message, nil if the edited message is an inline message
+}
+
+func (result *EditResult) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("true")) {
+		result.Message = nil
+		return nil
+	}
+	return json.Unmarshal(data, &result.Message)
+}
+
+func (result *EditResult) IsInline() bool {
+	return result.Message == nil
+}
@@ -0,0 +1,515 @@
+package gobot
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	MessageTextMaxLength = 4096
+	CaptionMaxLength     = 1024
+)
+
+// TextChunk is a part of a text split by SplitText, with its own entities.
+type TextChunk struct {
+	Text     string
+	Entities []*MessageEntity
+}
+
+type splitToken struct {
+	raw    string // Raw text, including markup
+	char   rune   // Visible character, 0 for markup
+	length int    // Length of the visible character in UTF-16 code units
+	open   bool   // True, if the token opens a markup that can be reopened in the next chunk
+	close  bool   // True, if the token closes the innermost open markup
+	closer string // For opening tokens, the raw markup that closes them
+	offset int    // Offset of the visible character in UTF-16 code units
+}
+
+// SplitText splits a text into chunks whose visible length, in UTF-16 code units, is at most limit.
+// It prefers splitting between paragraphs, then lines, then words, and it never breaks HTML tags, Markdown
+// markup or entities: the formatting open at the end of a chunk is closed and reopened in the next one.
+func SplitText(text string, parseMode string, entities []*MessageEntity, limit int) []TextChunk {
+	return splitText(text, parseMode, entities, limit, limit)
+}
+
+// splitText is SplitText with a different limit for the first chunk, e.g. a caption followed by messages.
+func splitText(text string, parseMode string, entities []*MessageEntity, firstLimit int, limit int) []TextChunk {
+	tokens := tokenize(text, parseMode)
+	var chunks []TextChunk
+	var stack []*splitToken
+	start := 0
+
+	for start < len(tokens) {
+		chunkLimit := limit
+
+		if len(chunks) == 0 {
+			chunkLimit = firstLimit
+		}
+		end, next := splitPoint(tokens, start, chunkLimit)
+		var raw strings.Builder
+
+		for _, token := range stack {
+			raw.WriteString(token.raw)
+		}
+
+		for _, token := range tokens[start:end] {
+			raw.WriteString(token.raw)
+
+			if token.open {
+				stack = append(stack, token)
+			} else if token.close && len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+
+		for i := len(stack) - 1; i >= 0; i-- {
+			raw.WriteString(stack[i].closer)
+		}
+
+		for _, token := range tokens[end:next] {
+			if token.open {
+				stack = append(stack, token)
+			} else if token.close && len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+		chunk := TextChunk{Text: raw.String()}
+
+		if parseMode == "" {
+			chunk.Entities = sliceEntities(entities, tokens, start, end)
+		}
+
+		if strings.TrimSpace(chunk.Text) != "" || len(chunk.Entities) > 0 {
+			chunks = append(chunks, chunk)
+		}
+		start = next
+	}
+	return chunks
+}
+
+// splitPoint returns the end of the chunk starting at start and the start of the next chunk; the tokens
+// in between are the whitespace the text is split at.
+func splitPoint(tokens []*splitToken, start int, limit int) (int, int) {
+	length := 0
+	end := start
+
+	for end < len(tokens) && length+tokens[end].length <= limit {
+		length += tokens[end].length
+		end++
+	}
+
+	if end == len(tokens) {
+		return end, end
+	}
+
+	for _, separator := range []string{"\n\n", "\n", " "} {
+		for minimum := limit / 2; ; minimum = 0 {
+			visible := 0
+
+			for i := start; i < end; i++ {
+				visible += tokens[i].length
+			}
+
+			for i := end; i > start; i-- {
+				visible -= tokens[i-1].length
+
+				if visible < minimum {
+					break
+				}
+
+				if visible > 0 && hasSeparator(tokens, i, separator) {
+					next := i
+
+					for next < len(tokens) && (tokens[next].char == ' ' || tokens[next].char == '\n') {
+						next++
+					}
+					return i, next
+				}
+			}
+
+			if minimum == 0 {
+				break
+			}
+		}
+	}
+
+	if end == start {
+		end++
+	}
+	return end, end
+}
+
+// hasSeparator reports whether the visible characters from index i match the separator.
+func hasSeparator(tokens []*splitToken, i int, separator string) bool {
+	for _, char := range separator {
+		for i < len(tokens) && tokens[i].char == 0 {
+			i++
+		}
+
+		if i == len(tokens) || tokens[i].char != char {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func sliceEntities(entities []*MessageEntity, tokens []*splitToken, start int, end int) []*MessageEntity {
+	if start == end {
+		return nil
+	}
+	from := tokens[start].offset
+	to := tokens[end-1].offset + tokens[end-1].length
+	var sliced []*MessageEntity
+
+	for _, entity := range entities {
+		entityStart, entityEnd := entity.Offset, entity.Offset+entity.Length
+
+		if entityStart < from {
+			entityStart = from
+		}
+
+		if entityEnd > to {
+			entityEnd = to
+		}
+
+		if entityStart < entityEnd {
+			copied := *entity
+			copied.Offset = entityStart - from
+			copied.Length = entityEnd - entityStart
+			sliced = append(sliced, &copied)
+		}
+	}
+	return sliced
+}
+
+func newCharToken(raw string, char rune, offset int) *splitToken {
+	return &splitToken{raw: raw, char: char, length: len(utf16.Encode([]rune{char})), offset: offset}
+}
+
+func tokenize(text string, parseMode string) []*splitToken {
+	switch parseMode {
+	case "HTML":
+		return tokenizeHTML(text)
+	case "MarkdownV2":
+		return tokenizeMarkdown(text, true)
+	case "Markdown":
+		return tokenizeMarkdown(text, false)
+	}
+	return tokenizePlain(text)
+}
+
+func tokenizePlain(text string) []*splitToken {
+	var tokens []*splitToken
+	offset := 0
+
+	for _, char := range text {
+		token := newCharToken(string(char), char, offset)
+		offset += token.length
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+var htmlEntities = map[string]rune{"&lt;": '<', "&gt;": '>', "&amp;": '&', "&quot;": '"'}
+
+func tokenizeHTML(text string) []*splitToken {
+	var tokens []*splitToken
+
+	for i := 0; i < len(text); {
+		switch {
+		case text[i] == '<':
+			end := i + 1
+			quote := byte(0)
+
+			for end < len(text) && (text[end] != '>' || quote != 0) {
+				if quote == 0 && (text[end] == '"' || text[end] == '\'') {
+					quote = text[end]
+				} else if text[end] == quote {
+					quote = 0
+				}
+				end++
+			}
+
+			if end < len(text) {
+				end++
+			}
+			tag := text[i:end]
+			token := &splitToken{raw: tag}
+
+			if strings.HasPrefix(tag, "</") {
+				token.close = true
+			} else if fields := strings.Fields(strings.Trim(tag, "<>/")); len(fields) > 0 {
+				token.open = true
+				token.closer = "</" + strings.ToLower(fields[0]) + ">"
+			}
+			tokens = append(tokens, token)
+			i = end
+		case text[i] == '&':
+			end := strings.IndexByte(text[i:], ';')
+
+			if end > 0 && end < 10 {
+				entity := text[i : i+end+1]
+				char, ok := htmlEntities[entity]
+
+				if !ok {
+					char = '?'
+				}
+				tokens = append(tokens, newCharToken(entity, char, 0))
+				i += end + 1
+				continue
+			}
+			tokens = append(tokens, newCharToken("&", '&', 0))
+			i++
+		default:
+			char, size := utf8.DecodeRuneInString(text[i:])
+			tokens = append(tokens, newCharToken(text[i:i+size], char, 0))
+			i += size
+		}
+	}
+	return tokens
+}
+
+// tokenizeMarkdown tokenizes both MarkdownV2 and, if v2 is false, the legacy Markdown parse mode.
+func tokenizeMarkdown(text string, v2 bool) []*splitToken {
+	var tokens []*splitToken
+	var stack []*splitToken
+	escapable := "_*`["
+
+	if v2 {
+		escapable = "\\_*[]()~`>#+-=|{}.!"
+	}
+	inCode := func() bool {
+		return len(stack) > 0 && strings.HasPrefix(stack[len(stack)-1].raw, "`")
+	}
+	toggle := func(delimiter string) {
+		if len(stack) > 0 && stack[len(stack)-1].closer == delimiter {
+			tokens = append(tokens, &splitToken{raw: delimiter, close: true})
+			stack = stack[:len(stack)-1]
+			return
+		}
+		token := &splitToken{raw: delimiter, open: true, closer: delimiter}
+		tokens = append(tokens, token)
+		stack = append(stack, token)
+	}
+
+	for i := 0; i < len(text); {
+		rest := text[i:]
+
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && strings.IndexByte(escapable, rest[1]) >= 0:
+			tokens = append(tokens, newCharToken(rest[:2], rune(rest[1]), 0))
+			i += 2
+		case strings.HasPrefix(rest, "```"):
+			if inCode() && stack[len(stack)-1].closer == "```" {
+				toggle("```")
+				i += 3
+				continue
+			}
+			end := strings.IndexByte(rest, '\n')
+
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			token := &splitToken{raw: rest[:end+1], open: true, closer: "```"}
+			tokens = append(tokens, token)
+			stack = append(stack, token)
+			i += end + 1
+		case rest[0] == '`' && (!inCode() || stack[len(stack)-1].closer == "`"):
+			toggle("`")
+			i++
+		case inCode():
+			char, size := utf8.DecodeRuneInString(rest)
+			tokens = append(tokens, newCharToken(rest[:size], char, 0))
+			i += size
+		case v2 && strings.HasPrefix(rest, "__"):
+			toggle("__")
+			i += 2
+		case v2 && strings.HasPrefix(rest, "||"):
+			toggle("||")
+			i += 2
+		case rest[0] == '*' || rest[0] == '_' || v2 && rest[0] == '~':
+			toggle(rest[:1])
+			i++
+		case rest[0] == '[':
+			token := &splitToken{raw: "[", open: true, closer: "]()"}
+			tokens = append(tokens, token)
+			stack = append(stack, token)
+			i++
+		case strings.HasPrefix(rest, "](") && len(stack) > 0 && stack[len(stack)-1].raw == "[":
+			end := strings.IndexByte(rest, ')')
+
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			stack[len(stack)-1].closer = rest[:end+1]
+			stack = stack[:len(stack)-1]
+			tokens = append(tokens, &splitToken{raw: rest[:end+1], close: true})
+			i += end + 1
+		case rest[0] == '\r' || v2 && rest[0] == '>' && (i == 0 || text[i-1] == '\n'):
+			tokens = append(tokens, &splitToken{raw: rest[:1]})
+			i++
+		default:
+			char, size := utf8.DecodeRuneInString(rest)
+			tokens = append(tokens, newCharToken(rest[:size], char, 0))
+			i += size
+		}
+	}
+	return tokens
+}
+
+// SendLongMessage sends a text longer than 4096 characters as multiple messages. Only the first message
+// replies to ReplyParameters or ReplyToMessageId and only the last one has the ReplyMarkup.
+func (bot GoBot) SendLongMessage(params SendMessageParams) ([]*Message, error) {
+	chunks := SplitText(params.Text, params.ParseMode, params.Entities, MessageTextMaxLength)
+
+	if len(chunks) == 0 {
+		return nil, ErrTextEmpty
+	}
+	var messages []*Message
+
+	for i, chunk := range chunks {
+		chunkParams := params
+		chunkParams.Text = chunk.Text
+		chunkParams.Entities = chunk.Entities
+
+		if i > 0 {
+			chunkParams.ReplyToMessageId = 0
+			chunkParams.ReplyParameters = nil
+		}
+
+		if i < len(chunks)-1 {
+			chunkParams.ReplyMarkup = nil
+		}
+		message, err := bot.SendMessage(chunkParams)
+
+		if err != nil {
+			return messages, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+var (
+	ErrTextEmpty        = errors.New("text must have at least one non-whitespace character")
+	ErrNotCaptionParams = errors.New("params must be a Send*Params struct with a Caption field")
+	typeReplyMarkup     = reflect.TypeOf((*ReplyMarkup)(nil)).Elem()
+	typeChatId          = reflect.TypeOf(&ChatId{})
+	typeString          = reflect.TypeOf("")
+	typeEntities        = reflect.TypeOf([]*MessageEntity{})
+)
+
+// hasField reports whether the struct has a field with the given name and type.
+func hasField(v reflect.Value, name string, fieldType reflect.Type) bool {
+	field := v.FieldByName(name)
+	return field.IsValid() && field.Type() == fieldType
+}
+
+// SendLongCaption sends a media message with the given method, e.g. "sendPhoto", and the matching params,
+// e.g. SendPhotoParams. If the caption is longer than 1024 characters, the part that doesn't fit is sent as
+// replies to the media of up to 4096 characters each, in the same topic and business connection; if
+// moveCaption is true the whole caption is sent in the replies instead. The reply markup is attached to the
+// last message.
+func (bot GoBot) SendLongCaption(method string, params interface{}, moveCaption bool) ([]*Message, error) {
+	v := reflect.ValueOf(params)
+
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct || !hasField(v, "ChatId", typeChatId) || !hasField(v, "Caption", typeString) ||
+		!hasField(v, "ParseMode", typeString) || !hasField(v, "CaptionEntities", typeEntities) {
+		return nil, ErrNotCaptionParams
+	}
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	caption := copied.FieldByName("Caption").String()
+	parseMode := copied.FieldByName("ParseMode").String()
+	entities, _ := copied.FieldByName("CaptionEntities").Interface().([]*MessageEntity)
+	var rest []TextChunk
+
+	if captionLength(caption, parseMode) > CaptionMaxLength {
+		if moveCaption {
+			rest = SplitText(caption, parseMode, entities, MessageTextMaxLength)
+			copied.FieldByName("Caption").SetString("")
+			copied.FieldByName("CaptionEntities").Set(reflect.Zero(copied.FieldByName("CaptionEntities").Type()))
+		} else {
+			rest = splitText(caption, parseMode, entities, CaptionMaxLength, MessageTextMaxLength)
+			copied.FieldByName("Caption").SetString(rest[0].Text)
+			copied.FieldByName("CaptionEntities").Set(reflect.ValueOf(rest[0].Entities))
+			rest = rest[1:]
+		}
+	}
+	var replyMarkup ReplyMarkup
+
+	if field := copied.FieldByName("ReplyMarkup"); len(rest) > 0 && field.IsValid() && field.Type() == typeReplyMarkup {
+		replyMarkup, _ = field.Interface().(ReplyMarkup)
+		field.Set(reflect.Zero(field.Type()))
+	}
+	response, err := bot.Request(method, copied.Interface())
+
+	if err != nil {
+		return nil, err
+	}
+	var media *Message
+
+	if err := json.Unmarshal(response, &media); err != nil {
+		return nil, err
+	}
+	messages := []*Message{media}
+
+	if len(rest) == 0 {
+		return messages, nil
+	}
+	disableNotification := copied.FieldByName("DisableNotification")
+	messageThreadId := copied.FieldByName("MessageThreadId")
+	businessConnectionId := copied.FieldByName("BusinessConnectionId")
+
+	for i, chunk := range rest {
+		chunkParams := SendMessageParams{
+			ChatId:          copied.FieldByName("ChatId").Interface().(*ChatId),
+			Text:            chunk.Text,
+			ParseMode:       parseMode,
+			Entities:        chunk.Entities,
+			ReplyParameters: &ReplyParameters{MessageId: media.MessageId},
+		}
+
+		if disableNotification.IsValid() && disableNotification.Kind() == reflect.Bool {
+			chunkParams.DisableNotification = disableNotification.Bool()
+		}
+
+		if messageThreadId.IsValid() && messageThreadId.Kind() == reflect.Int {
+			chunkParams.MessageThreadId = int(messageThreadId.Int())
+		}
+
+		if businessConnectionId.IsValid() && businessConnectionId.Kind() == reflect.String {
+			chunkParams.BusinessConnectionId = businessConnectionId.String()
+		}
+
+		if i == len(rest)-1 {
+			chunkParams.ReplyMarkup = replyMarkup
+		}
+		message, err := bot.SendMessage(chunkParams)
+
+		if err != nil {
+			return messages, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func captionLength(caption string, parseMode string) int {
+	length := 0
+
+	for _, token := range tokenize(caption, parseMode) {
+		length += token.length
+	}
+	return length
+}
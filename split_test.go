@@ -0,0 +1,211 @@
+package gobot
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestSplitTextUTF16(t *testing.T) {
+	tests := []struct {
+		text   string
+		limit  int
+		chunks []string
+	}{
+		{"aaa😀b", 4, []string{"aaa", "😀b"}},
+		{"😀😀😀", 4, []string{"😀😀", "😀"}},
+		{"😀😀😀", 3, []string{"😀", "😀", "😀"}},
+		{"ab 😀😀", 4, []string{"ab", "😀😀"}},
+	}
+
+	for _, test := range tests {
+		var chunks []string
+
+		for _, chunk := range SplitText(test.text, "", nil, test.limit) {
+			if length := len(utf16.Encode([]rune(chunk.Text))); length > test.limit {
+				t.Errorf("%q: chunk %q is %d UTF-16 code units long", test.text, chunk.Text, length)
+			}
+			chunks = append(chunks, chunk.Text)
+		}
+
+		if !reflect.DeepEqual(chunks, test.chunks) {
+			t.Errorf("%q split at %d: expected %q, got %q", test.text, test.limit, test.chunks, chunks)
+		}
+	}
+}
+
+func TestSplitTextSeparators(t *testing.T) {
+	tests := []struct {
+		text   string
+		limit  int
+		chunks []string
+	}{
+		{"hello world foo", 11, []string{"hello world", "foo"}},
+		{"first line\nsecond one", 15, []string{"first line", "second one"}},
+		{"one two\n\nthree four", 15, []string{"one two", "three four"}},
+		{"abcdefgh", 3, []string{"abc", "def", "gh"}},
+		{"   ", 2, nil},
+	}
+
+	for _, test := range tests {
+		var chunks []string
+
+		for _, chunk := range SplitText(test.text, "", nil, test.limit) {
+			chunks = append(chunks, chunk.Text)
+		}
+
+		if !reflect.DeepEqual(chunks, test.chunks) {
+			t.Errorf("%q split at %d: expected %q, got %q", test.text, test.limit, test.chunks, chunks)
+		}
+	}
+}
+
+func TestSplitTextEntities(t *testing.T) {
+	tests := []struct {
+		text     string
+		entities []*MessageEntity
+		limit    int
+		chunks   []TextChunk
+	}{
+		{
+			"aaaa bbbb", []*MessageEntity{{Type: "bold", Offset: 2, Length: 5}}, 4,
+			[]TextChunk{
+				{"aaaa", []*MessageEntity{{Type: "bold", Offset: 2, Length: 2}}},
+				{"bbbb", []*MessageEntity{{Type: "bold", Offset: 0, Length: 2}}},
+			},
+		},
+		{
+			"😀😀 😀😀", []*MessageEntity{{Type: "italic", Offset: 0, Length: 9}, {Type: "bold", Offset: 7, Length: 2}}, 4,
+			[]TextChunk{
+				{"😀😀", []*MessageEntity{{Type: "italic", Offset: 0, Length: 4}}},
+				{"😀😀", []*MessageEntity{{Type: "italic", Offset: 0, Length: 4}, {Type: "bold", Offset: 2, Length: 2}}},
+			},
+		},
+		{
+			"ab cd", []*MessageEntity{{Type: "text_link", Offset: 3, Length: 2, Url: "https://example.com"}}, 2,
+			[]TextChunk{
+				{"ab", nil},
+				{"cd", []*MessageEntity{{Type: "text_link", Offset: 0, Length: 2, Url: "https://example.com"}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		if chunks := SplitText(test.text, "", test.entities, test.limit); !reflect.DeepEqual(chunks, test.chunks) {
+			t.Errorf("%q: expected %+v, got %+v", test.text, test.chunks, chunks)
+		}
+	}
+}
+
+func TestSplitTextMarkup(t *testing.T) {
+	tests := []struct {
+		text      string
+		parseMode string
+		limit     int
+		chunks    []string
+	}{
+		{"<b>aaaa bbbb</b>", "HTML", 4, []string{"<b>aaaa</b>", "<b>bbbb</b>"}},
+		{`<a href="x y">aa <i>bb</i></a>`, "HTML", 2, []string{`<a href="x y">aa</a>`, `<a href="x y"><i>bb</i></a>`}},
+		{"&lt;&gt;&amp; ab", "HTML", 3, []string{"&lt;&gt;&amp;", "ab"}},
+		{"*aaaa bbbb*", "MarkdownV2", 4, []string{"*aaaa*", "*bbbb*"}},
+		{"_a\\_b c_", "MarkdownV2", 3, []string{"_a\\_b_", "_c_"}},
+		{"[ab cd](https://example.com)", "MarkdownV2", 2, []string{"[ab](https://example.com)", "[cd](https://example.com)"}},
+		{"*aa bb*", "Markdown", 2, []string{"*aa*", "*bb*"}},
+	}
+
+	for _, test := range tests {
+		var chunks []string
+
+		for _, chunk := range SplitText(test.text, test.parseMode, nil, test.limit) {
+			chunks = append(chunks, chunk.Text)
+		}
+
+		if !reflect.DeepEqual(chunks, test.chunks) {
+			t.Errorf("%s %q: expected %q, got %q", test.parseMode, test.text, test.chunks, chunks)
+		}
+	}
+}
+
+// recordingBot returns a bot whose requests are recorded instead of sent, answered with a message.
+func recordingBot(requests *[]interface{}) *GoBot {
+	bot := Init("123:token")
+	bot.SetLogger(nil)
+	bot.SetTransport(TransportFunc(func(method string, params interface{}) (json.RawMessage, error) {
+		*requests = append(*requests, params)
+		return json.Marshal(&Message{MessageId: len(*requests), Chat: &Chat{Id: 1}})
+	}))
+	return bot
+}
+
+func TestSendLongMessageEmpty(t *testing.T) {
+	var requests []interface{}
+	bot := recordingBot(&requests)
+
+	for _, text := range []string{"", " \n "} {
+		if _, err := bot.SendLongMessage(SendMessageParams{ChatId: NewChatId(1), Text: text}); !errors.Is(err, ErrTextEmpty) {
+			t.Errorf("%q: expected ErrTextEmpty, got %v", text, err)
+		}
+	}
+
+	if len(requests) != 0 {
+		t.Errorf("expected no requests, got %d", len(requests))
+	}
+}
+
+func TestSendLongCaption(t *testing.T) {
+	var requests []interface{}
+	bot := recordingBot(&requests)
+	caption := strings.Repeat("word ", 1000)
+	messages, err := bot.SendLongCaption("sendPhoto", SendPhotoParams{
+		ChatId:          NewChatId(1),
+		MessageThreadId: 7,
+		Caption:         caption,
+	}, false)
+
+	if err != nil {
+		t.Fatal(err)
+	} else if len(messages) != 2 || len(requests) != 2 {
+		t.Fatalf("expected the photo and one message, got %d messages", len(messages))
+	}
+	photo := requests[0].(SendPhotoParams)
+	message := requests[1].(SendMessageParams)
+
+	if len(photo.Caption) > CaptionMaxLength {
+		t.Errorf("expected a caption of at most %d characters, got %d", CaptionMaxLength, len(photo.Caption))
+	}
+
+	if len(message.Text) <= CaptionMaxLength || len(message.Text) > MessageTextMaxLength {
+		t.Errorf("expected the rest of the caption in one message, got %d characters", len(message.Text))
+	}
+
+	if photo.Caption+" "+message.Text != caption {
+		t.Error("expected the caption and the message to make up the whole caption")
+	}
+
+	if message.MessageThreadId != 7 || message.ReplyParameters == nil || message.ReplyParameters.MessageId != 1 {
+		t.Errorf("expected the message to reply to the photo in the same topic, got %+v", message)
+	}
+}
+
+func TestSendLongCaptionMoved(t *testing.T) {
+	var requests []interface{}
+	bot := recordingBot(&requests)
+	caption := strings.Repeat("word ", 1000)
+
+	if _, err := bot.SendLongCaption("sendPhoto", &SendPhotoParams{ChatId: NewChatId(1), Caption: caption}, true); err != nil {
+		t.Fatal(err)
+	} else if len(requests) != 3 {
+		t.Fatalf("expected the photo and two messages, got %d requests", len(requests))
+	}
+
+	if photo := requests[0].(SendPhotoParams); photo.Caption != "" {
+		t.Errorf("expected the photo without caption, got %d characters", len(photo.Caption))
+	}
+
+	if message := requests[1].(SendMessageParams); len(message.Text) <= CaptionMaxLength {
+		t.Errorf("expected messages longer than a caption, got %d characters", len(message.Text))
+	}
+}
@@ -0,0 +1,67 @@
+package gobot
+
+import (
+	"context"
+	"errors"
+)
+
+// Tracer starts the spans of the bot: one for every update handled, with a child for every request made
+// while handling it. It's meant to be implemented by an adapter, e.g. to an OpenTelemetry tracer.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+type Span interface {
+	SetAttribute(key string, value interface{})
+	SetError(err error)
+	End()
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+func (noopSpan) SetError(error)                   {}
+func (noopSpan) End()                             {}
+
+func (bot *GoBot) SetTracer(tracer Tracer) {
+	bot.tracer = tracer
+}
+
+// Context returns the context of the bot: the one of the span of the update for the bot passed to the
+// handlers, else the one given to WithContext, else the background context.
+func (bot *GoBot) Context() context.Context {
+	if bot.ctx == nil {
+		return context.Background()
+	}
+	return bot.ctx
+}
+
+// WithContext returns a copy of the bot whose requests are traced as children of the span in ctx. The copy
+// shares the handlers, the settings and the state of bot, so that setting them up through the copy applies
+// to bot too, but the exported fields, e.g. Timeout, are copied.
+func (bot *GoBot) WithContext(ctx context.Context) *GoBot {
+	copied := *bot
+	copied.ctx = ctx
+	return &copied
+}
+
+func (bot *GoBot) startSpan(name string) (context.Context, Span) {
+	if bot.tracer == nil {
+		return bot.Context(), noopSpan{}
+	}
+	return bot.tracer.Start(bot.Context(), name)
+}
+
+// endRequestSpan records the error of a request, with its error code if it's an error of the Bot API.
+func endRequestSpan(span Span, err error) {
+	var apiError *Error
+
+	if errors.As(err, &apiError) {
+		span.SetAttribute("telegram.error_code", apiError.ErrorCode)
+	}
+
+	if err != nil {
+		span.SetError(err)
+	}
+	span.End()
+}
@@ -0,0 +1,54 @@
+package gobot
+
+import (
+	"encoding/json"
+)
+
+// Transport makes the requests to the Bot API, e.g. over HTTP or against a recording.
+type Transport interface {
+	Do(method string, params interface{}) (json.RawMessage, error)
+}
+
+// TransportFunc adapts a function to a Transport.
+type TransportFunc func(method string, params interface{}) (json.RawMessage, error)
+
+func (do TransportFunc) Do(method string, params interface{}) (json.RawMessage, error) {
+	return do(method, params)
+}
+
+type httpTransport struct {
+	bot *GoBot
+}
+
+func (transport httpTransport) Do(method string, params interface{}) (json.RawMessage, error) {
+	return transport.bot.send(method, params)
+}
+
+// SetTransport makes the bot send its requests through the transport. Transports usually wrap the
+// current one, returned by Transport.
+func (bot *GoBot) SetTransport(transport Transport) {
+	bot.transport = transport
+}
+
+// Transport returns the transport used by the bot, which by default sends the requests over HTTP to
+// the Bot API server.
+func (bot *GoBot) Transport() Transport {
+	if bot.transport != nil {
+		return bot.transport
+	}
+	return httpTransport{bot}
+}
+
+// UpdateHandler handles an update, like the callbacks passed to AddHandler.
+type UpdateHandler func(bot *GoBot, update *Update)
+
+// Middleware wraps the handling of every update: it can inspect the update, skip it by not calling
+// next, or act after the handlers returned.
+type Middleware func(next UpdateHandler) UpdateHandler
+
+// Use adds middlewares, which are called in the order they were added before the handlers.
+func (bot *GoBot) Use(middlewares ...Middleware) {
+	bot.mutex.Lock()
+	defer bot.mutex.Unlock()
+	bot.middlewares = append(bot.middlewares, middlewares...)
+}
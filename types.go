@@ -1,51 +1,46 @@
+// Code generated by gobot-gen from Bot API 7.10. DO NOT EDIT.
+
 package gobot
 
 import "encoding/json"
 
-type Body struct {
-	Ok          bool                `json:"ok"`
-	Description string              `json:"description,omitempty"`
-	ErrorCode   int                 `json:"error_code,omitempty"`
-	Result      json.RawMessage     `json:"result,omitempty"`
-	Parameters  *ResponseParameters `json:"parameters,omitempty"`
-}
-
-type Error struct {
-	Description string
-	ErrorCode   int
-	Parameters  *ResponseParameters
-}
-
-func (err *Error) Error() string {
-	return err.Description
-}
-
 type Update struct {
-	UpdateId           int                 `json:"update_id"`                      // The update's unique identifier. Update identifiers start from a certain positive number and increase sequentially. This ID becomes especially handy if you're using Webhooks, since it allows you to ignore repeated updates or to restore the correct update sequence, should they get out of order. If there are no new updates for at least a week, then identifier of the next update will be chosen randomly instead of sequentially.
-	Message            *Message            `json:"message,omitempty"`              // Optional. New incoming message of any kind — text, photo, sticker, etc.
-	EditedMessage      *Message            `json:"edited_message,omitempty"`       // Optional. New version of a message that is known to the bot and was edited
-	ChannelPost        *Message            `json:"channel_post,omitempty"`         // Optional. New incoming channel post of any kind — text, photo, sticker, etc.
-	EditedChannelPost  *Message            `json:"edited_channel_post,omitempty"`  // Optional. New version of a channel post that is known to the bot and was edited
-	InlineQuery        *InlineQuery        `json:"inline_query,omitempty"`         // Optional. New incoming inline query
-	ChosenInlineResult *ChosenInlineResult `json:"chosen_inline_result,omitempty"` // Optional. The result of an inline query that was chosen by a user and sent to their chat partner. Please see our documentation on the feedback collecting for details on how to enable these updates for your bot.
-	CallbackQuery      *CallbackQuery      `json:"callback_query,omitempty"`       // Optional. New incoming callback query
-	ShippingQuery      *ShippingQuery      `json:"shipping_query,omitempty"`       // Optional. New incoming shipping query. Only for invoices with flexible price
-	PreCheckoutQuery   *PreCheckoutQuery   `json:"pre_checkout_query,omitempty"`   // Optional. New incoming pre-checkout query. Contains full information about checkout
-	Poll               *Poll               `json:"poll,omitempty"`                 // Optional. New poll state. Bots receive only updates about stopped polls and polls, which are sent by the bot
-	PollAnswer         *PollAnswer         `json:"poll_answer,omitempty"`          // Optional. A user changed their answer in a non-anonymous poll. Bots receive new votes only in polls that were sent by the bot itself.
-	MyChatMember       *ChatMemberUpdated  `json:"my_chat_member,omitempty"`       // Optional. The bot's chat member status was updated in a chat. For private chats, this update is received only when the bot is blocked or unblocked by the user.
-	ChatMember         *ChatMemberUpdated  `json:"chat_member,omitempty"`          // Optional. A chat member's status was updated in a chat. The bot must be an administrator in the chat and must explicitly specify "chat_member" in the list of allowed_updates to receive these updates.
+	UpdateId                int                          `json:"update_id"`                           // The update's unique identifier. Update identifiers start from a certain positive number and increase sequentially. This ID becomes especially handy if you're using Webhooks, since it allows you to ignore repeated updates or to restore the correct update sequence, should they get out of order. If there are no new updates for at least a week, then identifier of the next update will be chosen randomly instead of sequentially.
+	Message                 *Message                     `json:"message,omitempty"`                   // Optional. New incoming message of any kind — text, photo, sticker, etc.
+	EditedMessage           *Message                     `json:"edited_message,omitempty"`            // Optional. New version of a message that is known to the bot and was edited
+	ChannelPost             *Message                     `json:"channel_post,omitempty"`              // Optional. New incoming channel post of any kind — text, photo, sticker, etc.
+	EditedChannelPost       *Message                     `json:"edited_channel_post,omitempty"`       // Optional. New version of a channel post that is known to the bot and was edited
+	BusinessConnection      *BusinessConnection          `json:"business_connection,omitempty"`       // Optional. The bot was connected to or disconnected from a business account, or a user edited an existing connection with the bot
+	BusinessMessage         *Message                     `json:"business_message,omitempty"`          // Optional. New message from a connected business account
+	EditedBusinessMessage   *Message                     `json:"edited_business_message,omitempty"`   // Optional. New version of a message from a connected business account
+	DeletedBusinessMessages *BusinessMessagesDeleted     `json:"deleted_business_messages,omitempty"` // Optional. Messages were deleted from a connected business account
+	MessageReaction         *MessageReactionUpdated      `json:"message_reaction,omitempty"`          // Optional. A reaction to a message was changed by a user. The bot must be an administrator in the chat and must explicitly specify "message_reaction" in the list of allowed_updates to receive these updates. The update isn't received for reactions set by bots.
+	MessageReactionCount    *MessageReactionCountUpdated `json:"message_reaction_count,omitempty"`    // Optional. Reactions to a message with anonymous reactions were changed. The bot must be an administrator in the chat and must explicitly specify "message_reaction_count" in the list of allowed_updates to receive these updates. The updates are grouped and can be sent with delay up to a few minutes.
+	InlineQuery             *InlineQuery                 `json:"inline_query,omitempty"`              // Optional. New incoming inline query
+	ChosenInlineResult      *ChosenInlineResult          `json:"chosen_inline_result,omitempty"`      // Optional. The result of an inline query that was chosen by a user and sent to their chat partner. Please see our documentation on the feedback collecting for details on how to enable these updates for your bot.
+	CallbackQuery           *CallbackQuery               `json:"callback_query,omitempty"`            // Optional. New incoming callback query
+	ShippingQuery           *ShippingQuery               `json:"shipping_query,omitempty"`            // Optional. New incoming shipping query. Only for invoices with flexible price
+	PreCheckoutQuery        *PreCheckoutQuery            `json:"pre_checkout_query,omitempty"`        // Optional. New incoming pre-checkout query. Contains full information about checkout
+	PurchasedPaidMedia      *PaidMediaPurchased          `json:"purchased_paid_media,omitempty"`      // Optional. A user purchased paid media with a non-empty payload sent by the bot in a non-channel chat
+	Poll                    *Poll                        `json:"poll,omitempty"`                      // Optional. New poll state. Bots receive only updates about stopped polls and polls, which are sent by the bot
+	PollAnswer              *PollAnswer                  `json:"poll_answer,omitempty"`               // Optional. A user changed their answer in a non-anonymous poll. Bots receive new votes only in polls that were sent by the bot itself.
+	MyChatMember            *ChatMemberUpdated           `json:"my_chat_member,omitempty"`            // Optional. The bot's chat member status was updated in a chat. For private chats, this update is received only when the bot is blocked or unblocked by the user.
+	ChatMember              *ChatMemberUpdated           `json:"chat_member,omitempty"`               // Optional. A chat member's status was updated in a chat. The bot must be an administrator in the chat and must explicitly specify "chat_member" in the list of allowed_updates to receive these updates.
+	ChatJoinRequest         *ChatJoinRequest             `json:"chat_join_request,omitempty"`         // Optional. A request to join the chat has been sent. The bot must have the can_invite_users administrator right in the chat to receive these updates.
+	ChatBoost               *ChatBoostUpdated            `json:"chat_boost,omitempty"`                // Optional. A chat boost was added or changed. The bot must be an administrator in the chat to receive these updates.
+	RemovedChatBoost        *ChatBoostRemoved            `json:"removed_chat_boost,omitempty"`        // Optional. A boost was removed from a chat. The bot must be an administrator in the chat to receive these updates.
 }
 
 type WebhookInfo struct {
-	Url                  string   `json:"url"`                          // Webhook URL, may be empty if webhook is not set up
-	HasCustomCertificate bool     `json:"has_custom_certificate"`       // True, if a custom certificate was provided for webhook certificate checks
-	PendingUpdateCount   int      `json:"pending_update_count"`         // Number of updates awaiting delivery
-	IpAddress            string   `json:"ip_address,omitempty"`         // Optional. Currently used webhook IP address
-	LastErrorDate        int      `json:"last_error_date,omitempty"`    // Optional. Unix time for the most recent error that happened when trying to deliver an update via webhook
-	LastErrorMessage     string   `json:"last_error_message,omitempty"` // Optional. Error message in human-readable format for the most recent error that happened when trying to deliver an update via webhook
-	MaxConnections       int      `json:"max_connections,omitempty"`    // Optional. Maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery
-	AllowedUpdates       []string `json:"allowed_updates,omitempty"`    // Optional. A list of update types the bot is subscribed to. Defaults to all update types except chat_member
+	Url                          string   `json:"url"`                                       // Webhook URL, may be empty if webhook is not set up
+	HasCustomCertificate         bool     `json:"has_custom_certificate"`                    // True, if a custom certificate was provided for webhook certificate checks
+	PendingUpdateCount           int      `json:"pending_update_count"`                      // Number of updates awaiting delivery
+	IpAddress                    string   `json:"ip_address,omitempty"`                      // Optional. Currently used webhook IP address
+	LastErrorDate                int      `json:"last_error_date,omitempty"`                 // Optional. Unix time for the most recent error that happened when trying to deliver an update via webhook
+	LastErrorMessage             string   `json:"last_error_message,omitempty"`              // Optional. Error message in human-readable format for the most recent error that happened when trying to deliver an update via webhook
+	LastSynchronizationErrorDate int      `json:"last_synchronization_error_date,omitempty"` // Optional. Unix time of the most recent error that happened when trying to synchronize available updates with Telegram datacenters
+	MaxConnections               int      `json:"max_connections,omitempty"`                 // Optional. Maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery
+	AllowedUpdates               []string `json:"allowed_updates,omitempty"`                 // Optional. A list of update types the bot is subscribed to. Defaults to all update types except chat_member
 }
 
 type User struct {
@@ -55,67 +50,137 @@ type User struct {
 	LastName                string `json:"last_name,omitempty"`                   // Optional. User's or bot's last name
 	Username                string `json:"username,omitempty"`                    // Optional. User's or bot's username
 	LanguageCode            string `json:"language_code,omitempty"`               // Optional. IETF language tag of the user's language
+	IsPremium               bool   `json:"is_premium,omitempty"`                  // Optional. True, if this user is a Telegram Premium user
+	AddedToAttachmentMenu   bool   `json:"added_to_attachment_menu,omitempty"`    // Optional. True, if this user added the bot to the attachment menu
 	CanJoinGroups           bool   `json:"can_join_groups,omitempty"`             // Optional. True, if the bot can be invited to groups. Returned only in getMe.
 	CanReadAllGroupMessages bool   `json:"can_read_all_group_messages,omitempty"` // Optional. True, if privacy mode is disabled for the bot. Returned only in getMe.
 	SupportsInlineQueries   bool   `json:"supports_inline_queries,omitempty"`     // Optional. True, if the bot supports inline queries. Returned only in getMe.
+	CanConnectToBusiness    bool   `json:"can_connect_to_business,omitempty"`     // Optional. True, if the bot can be connected to a Telegram Business account to receive its messages. Returned only in getMe.
+	HasMainWebApp           bool   `json:"has_main_web_app,omitempty"`            // Optional. True, if the bot has a main Web App. Returned only in getMe.
 }
 
 type Chat struct {
-	Id                    int              `json:"id"`                                 // Unique identifier for this chat. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
-	Type                  string           `json:"type"`                               // Type of chat, can be either "private", "group", "supergroup" or "channel"
-	Title                 string           `json:"title,omitempty"`                    // Optional. Title, for supergroups, channels and group chats
-	Username              string           `json:"username,omitempty"`                 // Optional. Username, for private chats, supergroups and channels if available
-	FirstName             string           `json:"first_name,omitempty"`               // Optional. First name of the other party in a private chat
-	LastName              string           `json:"last_name,omitempty"`                // Optional. Last name of the other party in a private chat
-	Photo                 *ChatPhoto       `json:"photo,omitempty"`                    // Optional. Chat photo. Returned only in getChat.
-	Bio                   string           `json:"bio,omitempty"`                      // Optional. Bio of the other party in a private chat. Returned only in getChat.
-	Description           string           `json:"description,omitempty"`              // Optional. Description, for groups, supergroups and channel chats. Returned only in getChat.
-	InviteLink            string           `json:"invite_link,omitempty"`              // Optional. Primary invite link, for groups, supergroups and channel chats. Returned only in getChat.
-	PinnedMessage         *Message         `json:"pinned_message,omitempty"`           // Optional. The most recent pinned message (by sending date). Returned only in getChat.
-	Permissions           *ChatPermissions `json:"permissions,omitempty"`              // Optional. Default chat member permissions, for groups and supergroups. Returned only in getChat.
-	SlowModeDelay         int              `json:"slow_mode_delay,omitempty"`          // Optional. For supergroups, the minimum allowed delay between consecutive messages sent by each unpriviledged user. Returned only in getChat.
-	MessageAutoDeleteTime int              `json:"message_auto_delete_time,omitempty"` // Optional. The time after which all messages sent to the chat will be automatically deleted; in seconds. Returned only in getChat.
-	StickerSetName        string           `json:"sticker_set_name,omitempty"`         // Optional. For supergroups, name of group sticker set. Returned only in getChat.
-	CanSetStickerSet      bool             `json:"can_set_sticker_set,omitempty"`      // Optional. True, if the bot can change the group sticker set. Returned only in getChat.
-	LinkedChatId          int              `json:"linked_chat_id,omitempty"`           // Optional. Unique identifier for the linked chat, i.e. the discussion group identifier for a channel and vice versa; for supergroups and channel chats. This identifier may be greater than 32 bits and some programming languages may have difficulty/silent defects in interpreting it. But it is smaller than 52 bits, so a signed 64 bit integer or double-precision float type are safe for storing this identifier. Returned only in getChat.
-	Location              *ChatLocation    `json:"location,omitempty"`                 // Optional. For supergroups, the location to which the supergroup is connected. Returned only in getChat.
+	Id        int    `json:"id"`                   // Unique identifier for this chat. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
+	Type      string `json:"type"`                 // Type of chat, can be either "private", "group", "supergroup" or "channel"
+	Title     string `json:"title,omitempty"`      // Optional. Title, for supergroups, channels and group chats
+	Username  string `json:"username,omitempty"`   // Optional. Username, for private chats, supergroups and channels if available
+	FirstName string `json:"first_name,omitempty"` // Optional. First name of the other party in a private chat
+	LastName  string `json:"last_name,omitempty"`  // Optional. Last name of the other party in a private chat
+	IsForum   bool   `json:"is_forum,omitempty"`   // Optional. True, if the supergroup chat is a forum (has topics enabled)
+}
+
+type ChatFullInfo struct {
+	Id                                 int                   `json:"id"`                                                // Unique identifier for this chat. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
+	Type                               string                `json:"type"`                                              // Type of chat, can be either "private", "group", "supergroup" or "channel"
+	Title                              string                `json:"title,omitempty"`                                   // Optional. Title, for supergroups, channels and group chats
+	Username                           string                `json:"username,omitempty"`                                // Optional. Username, for private chats, supergroups and channels if available
+	FirstName                          string                `json:"first_name,omitempty"`                              // Optional. First name of the other party in a private chat
+	LastName                           string                `json:"last_name,omitempty"`                               // Optional. Last name of the other party in a private chat
+	IsForum                            bool                  `json:"is_forum,omitempty"`                                // Optional. True, if the supergroup chat is a forum (has topics enabled)
+	AccentColorId                      int                   `json:"accent_color_id"`                                   // Identifier of the accent color for the chat name and backgrounds of the chat photo, reply header, and link preview
+	MaxReactionCount                   int                   `json:"max_reaction_count"`                                // The maximum number of reactions that can be set on a message in the chat
+	Photo                              *ChatPhoto            `json:"photo,omitempty"`                                   // Optional. Chat photo. Returned only in getChat.
+	ActiveUsernames                    []string              `json:"active_usernames,omitempty"`                        // Optional. If non-empty, the list of all active chat usernames; for private chats, supergroups and channels
+	Birthdate                          *Birthdate            `json:"birthdate,omitempty"`                               // Optional. For private chats, the date of birth of the user
+	BusinessIntro                      *BusinessIntro        `json:"business_intro,omitempty"`                          // Optional. For private chats with business accounts, the intro of the business
+	BusinessLocation                   *BusinessLocation     `json:"business_location,omitempty"`                       // Optional. For private chats with business accounts, the location of the business
+	BusinessOpeningHours               *BusinessOpeningHours `json:"business_opening_hours,omitempty"`                  // Optional. For private chats with business accounts, the opening hours of the business
+	PersonalChat                       *Chat                 `json:"personal_chat,omitempty"`                           // Optional. For private chats, the personal channel of the user
+	AvailableReactions                 []ReactionType        `json:"available_reactions,omitempty"`                     // Optional. List of available reactions allowed in the chat. If omitted, then all emoji reactions are allowed.
+	BackgroundCustomEmojiId            string                `json:"background_custom_emoji_id,omitempty"`              // Optional. Custom emoji identifier of the emoji chosen by the chat for the reply header and link preview background
+	ProfileAccentColorId               int                   `json:"profile_accent_color_id,omitempty"`                 // Optional. Identifier of the accent color for the chat's profile background
+	ProfileBackgroundCustomEmojiId     string                `json:"profile_background_custom_emoji_id,omitempty"`      // Optional. Custom emoji identifier of the emoji chosen by the chat for its profile background
+	EmojiStatusCustomEmojiId           string                `json:"emoji_status_custom_emoji_id,omitempty"`            // Optional. Custom emoji identifier of the emoji status of the chat or the other party in a private chat
+	EmojiStatusExpirationDate          int                   `json:"emoji_status_expiration_date,omitempty"`            // Optional. Expiration date of the emoji status of the chat or the other party in a private chat, in Unix time, if any
+	Bio                                string                `json:"bio,omitempty"`                                     // Optional. Bio of the other party in a private chat. Returned only in getChat.
+	HasPrivateForwards                 bool                  `json:"has_private_forwards,omitempty"`                    // Optional. True, if privacy settings of the other party in the private chat allows to use tg://user?id=<user_id> links only in chats with the user
+	HasRestrictedVoiceAndVideoMessages bool                  `json:"has_restricted_voice_and_video_messages,omitempty"` // Optional. True, if the privacy settings of the other party restrict sending voice and video note messages in the private chat
+	JoinToSendMessages                 bool                  `json:"join_to_send_messages,omitempty"`                   // Optional. True, if users need to join the supergroup before they can send messages
+	JoinByRequest                      bool                  `json:"join_by_request,omitempty"`                         // Optional. True, if all users directly joining the supergroup without using an invite link need to be approved by supergroup administrators
+	Description                        string                `json:"description,omitempty"`                             // Optional. Description, for groups, supergroups and channel chats. Returned only in getChat.
+	InviteLink                         string                `json:"invite_link,omitempty"`                             // Optional. Primary invite link, for groups, supergroups and channel chats. Returned only in getChat.
+	PinnedMessage                      *Message              `json:"pinned_message,omitempty"`                          // Optional. The most recent pinned message (by sending date). Returned only in getChat.
+	Permissions                        *ChatPermissions      `json:"permissions,omitempty"`                             // Optional. Default chat member permissions, for groups and supergroups. Returned only in getChat.
+	CanSendPaidMedia                   bool                  `json:"can_send_paid_media,omitempty"`                     // Optional. True, if paid media messages can be sent or forwarded to the channel chat. The field is available only for channel chats.
+	SlowModeDelay                      int                   `json:"slow_mode_delay,omitempty"`                         // Optional. For supergroups, the minimum allowed delay between consecutive messages sent by each unpriviledged user. Returned only in getChat.
+	UnrestrictBoostCount               int                   `json:"unrestrict_boost_count,omitempty"`                  // Optional. For supergroups, the minimum number of boosts that a non-administrator user needs to add in order to ignore slow mode and chat permissions
+	MessageAutoDeleteTime              int                   `json:"message_auto_delete_time,omitempty"`                // Optional. The time after which all messages sent to the chat will be automatically deleted; in seconds. Returned only in getChat.
+	HasAggressiveAntiSpamEnabled       bool                  `json:"has_aggressive_anti_spam_enabled,omitempty"`        // Optional. True, if aggressive anti-spam checks are enabled in the supergroup. The field is only available to chat administrators.
+	HasHiddenMembers                   bool                  `json:"has_hidden_members,omitempty"`                      // Optional. True, if non-administrators can only get the list of bots and administrators in the chat
+	HasProtectedContent                bool                  `json:"has_protected_content,omitempty"`                   // Optional. True, if messages from the chat can't be forwarded to other chats
+	HasVisibleHistory                  bool                  `json:"has_visible_history,omitempty"`                     // Optional. True, if new chat members will have access to old messages; available only to chat administrators
+	StickerSetName                     string                `json:"sticker_set_name,omitempty"`                        // Optional. For supergroups, name of group sticker set. Returned only in getChat.
+	CanSetStickerSet                   bool                  `json:"can_set_sticker_set,omitempty"`                     // Optional. True, if the bot can change the group sticker set. Returned only in getChat.
+	CustomEmojiStickerSetName          string                `json:"custom_emoji_sticker_set_name,omitempty"`           // Optional. For supergroups, the name of the group's custom emoji sticker set. Custom emoji from this set can be used by all users and bots in the group.
+	LinkedChatId                       int                   `json:"linked_chat_id,omitempty"`                          // Optional. Unique identifier for the linked chat, i.e. the discussion group identifier for a channel and vice versa; for supergroups and channel chats. This identifier may be greater than 32 bits and some programming languages may have difficulty/silent defects in interpreting it. But it is smaller than 52 bits, so a signed 64 bit integer or double-precision float type are safe for storing this identifier. Returned only in getChat.
+	Location                           *ChatLocation         `json:"location,omitempty"`                                // Optional. For supergroups, the location to which the supergroup is connected. Returned only in getChat.
+}
+
+func (chatFullInfo *ChatFullInfo) UnmarshalJSON(data []byte) error {
+	type plain ChatFullInfo
+	decoded := struct {
+		*plain
+		AvailableReactions json.RawMessage `json:"available_reactions"`
+	}{plain: (*plain)(chatFullInfo)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	chatFullInfo.AvailableReactions, err = unmarshalReactionTypes(decoded.AvailableReactions)
+	return err
 }
 
 type Message struct {
 	MessageId                     int                            `json:"message_id"`                                  // Unique message identifier inside this chat
+	MessageThreadId               int                            `json:"message_thread_id,omitempty"`                 // Optional. Unique identifier of a message thread to which the message belongs; for supergroups only
 	From                          *User                          `json:"from,omitempty"`                              // Optional. Sender, empty for messages sent to channels
 	SenderChat                    *Chat                          `json:"sender_chat,omitempty"`                       // Optional. Sender of the message, sent on behalf of a chat. The channel itself for channel messages. The supergroup itself for messages from anonymous group administrators. The linked channel for messages automatically forwarded to the discussion group
+	SenderBoostCount              int                            `json:"sender_boost_count,omitempty"`                // Optional. If the sender of the message boosted the chat, the number of boosts added by the user
+	SenderBusinessBot             *User                          `json:"sender_business_bot,omitempty"`               // Optional. The bot that actually sent the message on behalf of the business account. Available only for outgoing messages sent on behalf of the connected business account.
 	Date                          int                            `json:"date"`                                        // Date the message was sent in Unix time
+	BusinessConnectionId          string                         `json:"business_connection_id,omitempty"`            // Optional. Unique identifier of the business connection from which the message was received. If non-empty, the message belongs to a chat of the corresponding business account that is independent from any potential bot chat which might share the same identifier.
 	Chat                          *Chat                          `json:"chat"`                                        // Conversation the message belongs to
-	ForwardFrom                   *User                          `json:"forward_from,omitempty"`                      // Optional. For forwarded messages, sender of the original message
-	ForwardFromChat               *Chat                          `json:"forward_from_chat,omitempty"`                 // Optional. For messages forwarded from channels or from anonymous administrators, information about the original sender chat
-	ForwardFromMessageId          int                            `json:"forward_from_message_id,omitempty"`           // Optional. For messages forwarded from channels, identifier of the original message in the channel
-	ForwardSignature              string                         `json:"forward_signature,omitempty"`                 // Optional. For messages forwarded from channels, signature of the post author if present
-	ForwardSenderName             string                         `json:"forward_sender_name,omitempty"`               // Optional. Sender's name for messages forwarded from users who disallow adding a link to their account in forwarded messages
-	ForwardDate                   int                            `json:"forward_date,omitempty"`                      // Optional. For forwarded messages, date the original message was sent in Unix time
+	ForwardOrigin                 MessageOrigin                  `json:"forward_origin,omitempty"`                    // Optional. Information about the original message for forwarded messages
+	IsTopicMessage                bool                           `json:"is_topic_message,omitempty"`                  // Optional. True, if the message is sent to a forum topic
+	IsAutomaticForward            bool                           `json:"is_automatic_forward,omitempty"`              // Optional. True, if the message is a channel post that was automatically forwarded to the connected discussion group
 	ReplyToMessage                *Message                       `json:"reply_to_message,omitempty"`                  // Optional. For replies, the original message. Note that the Message object in this field will not contain further reply_to_message fields even if it itself is a reply.
+	ExternalReply                 *ExternalReplyInfo             `json:"external_reply,omitempty"`                    // Optional. Information about the message that is being replied to, which may come from another chat or forum topic
+	Quote                         *TextQuote                     `json:"quote,omitempty"`                             // Optional. For replies that quote part of the original message, the quoted part of the message
+	ReplyToStory                  *Story                         `json:"reply_to_story,omitempty"`                    // Optional. For replies to a story, the original story
 	ViaBot                        *User                          `json:"via_bot,omitempty"`                           // Optional. Bot through which the message was sent
 	EditDate                      int                            `json:"edit_date,omitempty"`                         // Optional. Date the message was last edited in Unix time
+	HasProtectedContent           bool                           `json:"has_protected_content,omitempty"`             // Optional. True, if the message can't be forwarded
+	IsFromOffline                 bool                           `json:"is_from_offline,omitempty"`                   // Optional. True, if the message was sent by an implicit action, for example, as an away or a greeting business message, or as a scheduled message
 	MediaGroupId                  string                         `json:"media_group_id,omitempty"`                    // Optional. The unique identifier of a media message group this message belongs to
 	AuthorSignature               string                         `json:"author_signature,omitempty"`                  // Optional. Signature of the post author for messages in channels, or the custom title of an anonymous group administrator
 	Text                          string                         `json:"text,omitempty"`                              // Optional. For text messages, the actual UTF-8 text of the message, 0-4096 characters
 	Entities                      []*MessageEntity               `json:"entities,omitempty"`                          // Optional. For text messages, special entities like usernames, URLs, bot commands, etc. that appear in the text
+	LinkPreviewOptions            *LinkPreviewOptions            `json:"link_preview_options,omitempty"`              // Optional. Options used for link preview generation for the message, if it is a text message and link preview options were changed
+	EffectId                      string                         `json:"effect_id,omitempty"`                         // Optional. Unique identifier of the message effect added to the message
 	Animation                     *Animation                     `json:"animation,omitempty"`                         // Optional. Message is an animation, information about the animation. For backward compatibility, when this field is set, the document field will also be set
 	Audio                         *Audio                         `json:"audio,omitempty"`                             // Optional. Message is an audio file, information about the file
 	Document                      *Document                      `json:"document,omitempty"`                          // Optional. Message is a general file, information about the file
+	PaidMedia                     *PaidMediaInfo                 `json:"paid_media,omitempty"`                        // Optional. Message contains paid media; information about the paid media
 	Photo                         []*PhotoSize                   `json:"photo,omitempty"`                             // Optional. Message is a photo, available sizes of the photo
 	Sticker                       *Sticker                       `json:"sticker,omitempty"`                           // Optional. Message is a sticker, information about the sticker
+	Story                         *Story                         `json:"story,omitempty"`                             // Optional. Message is a forwarded story
 	Video                         *Video                         `json:"video,omitempty"`                             // Optional. Message is a video, information about the video
 	VideoNote                     *VideoNote                     `json:"video_note,omitempty"`                        // Optional. Message is a video note, information about the video message
 	Voice                         *Voice                         `json:"voice,omitempty"`                             // Optional. Message is a voice message, information about the file
-	Caption                       string                         `json:"caption,omitempty"`                           // Optional. Caption for the animation, audio, document, photo, video or voice, 0-1024 characters
+	Caption                       string                         `json:"caption,omitempty" validate:"len=0-1024"`     // Optional. Caption for the animation, audio, document, photo, video or voice, 0-1024 characters
 	CaptionEntities               []*MessageEntity               `json:"caption_entities,omitempty"`                  // Optional. For messages with a caption, special entities like usernames, URLs, bot commands, etc. that appear in the caption
+	ShowCaptionAboveMedia         bool                           `json:"show_caption_above_media,omitempty"`          // Optional. True, if the caption must be shown above the message media
+	HasMediaSpoiler               bool                           `json:"has_media_spoiler,omitempty"`                 // Optional. True, if the message media is covered by a spoiler animation
 	Contact                       *Contact                       `json:"contact,omitempty"`                           // Optional. Message is a shared contact, information about the contact
 	Dice                          *Dice                          `json:"dice,omitempty"`                              // Optional. Message is a dice with random value
 	Game                          *Game                          `json:"game,omitempty"`                              // Optional. Message is a game, information about the game. More about games »
+	Giveaway                      *Giveaway                      `json:"giveaway,omitempty"`                          // Optional. The message is a scheduled giveaway message
+	GiveawayWinners               *GiveawayWinners               `json:"giveaway_winners,omitempty"`                  // Optional. A giveaway with public winners was completed
+	Invoice                       *Invoice                       `json:"invoice,omitempty"`                           // Optional. Message is an invoice for a payment, information about the invoice. More about payments »
+	Location                      *Location                      `json:"location,omitempty"`                          // Optional. Message is a shared location, information about the location
 	Poll                          *Poll                          `json:"poll,omitempty"`                              // Optional. Message is a native poll, information about the poll
 	Venue                         *Venue                         `json:"venue,omitempty"`                             // Optional. Message is a venue, information about the venue. For backward compatibility, when this field is set, the location field will also be set
-	Location                      *Location                      `json:"location,omitempty"`                          // Optional. Message is a shared location, information about the location
 	NewChatMembers                []*User                        `json:"new_chat_members,omitempty"`                  // Optional. New members that were added to the group or supergroup and information about them (the bot itself may be one of these members)
 	LeftChatMember                *User                          `json:"left_chat_member,omitempty"`                  // Optional. A member was removed from the group, information about them (this member may be the bot itself)
 	NewChatTitle                  string                         `json:"new_chat_title,omitempty"`                    // Optional. A chat title was changed to this value
@@ -128,29 +193,141 @@ type Message struct {
 	MigrateToChatId               int                            `json:"migrate_to_chat_id,omitempty"`                // Optional. The group has been migrated to a supergroup with the specified identifier. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
 	MigrateFromChatId             int                            `json:"migrate_from_chat_id,omitempty"`              // Optional. The supergroup has been migrated from a group with the specified identifier. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
 	PinnedMessage                 *Message                       `json:"pinned_message,omitempty"`                    // Optional. Specified message was pinned. Note that the Message object in this field will not contain further reply_to_message fields even if it is itself a reply.
-	Invoice                       *Invoice                       `json:"invoice,omitempty"`                           // Optional. Message is an invoice for a payment, information about the invoice. More about payments »
 	SuccessfulPayment             *SuccessfulPayment             `json:"successful_payment,omitempty"`                // Optional. Message is a service message about a successful payment, information about the payment. More about payments »
+	RefundedPayment               *RefundedPayment               `json:"refunded_payment,omitempty"`                  // Optional. Message is a service message about a refunded payment, information about the payment
+	UsersShared                   *UsersShared                   `json:"users_shared,omitempty"`                      // Optional. Service message: users were shared with the bot
+	ChatShared                    *ChatShared                    `json:"chat_shared,omitempty"`                       // Optional. Service message: a chat was shared with the bot
 	ConnectedWebsite              string                         `json:"connected_website,omitempty"`                 // Optional. The domain name of the website on which the user has logged in. More about Telegram Login »
+	WriteAccessAllowed            *WriteAccessAllowed            `json:"write_access_allowed,omitempty"`              // Optional. Service message: the user allowed the bot to write messages after adding it to the attachment or side menu, launching a Web App from a link, or accepting an explicit request from a Web App sent by the method requestWriteAccess
 	PassportData                  *PassportData                  `json:"passport_data,omitempty"`                     // Optional. Telegram Passport data
 	ProximityAlertTriggered       *ProximityAlertTriggered       `json:"proximity_alert_triggered,omitempty"`         // Optional. Service message. A user in the chat triggered another user's proximity alert while sharing Live Location.
-	VoiceChatScheduled            *VoiceChatScheduled            `json:"voice_chat_scheduled,omitempty"`              // Optional. Service message: voice chat scheduled
-	VoiceChatStarted              *VoiceChatStarted              `json:"voice_chat_started,omitempty"`                // Optional. Service message: voice chat started
-	VoiceChatEnded                *VoiceChatEnded                `json:"voice_chat_ended,omitempty"`                  // Optional. Service message: voice chat ended
-	VoiceChatParticipantsInvited  *VoiceChatParticipantsInvited  `json:"voice_chat_participants_invited,omitempty"`   // Optional. Service message: new participants invited to a voice chat
+	BoostAdded                    *ChatBoostAdded                `json:"boost_added,omitempty"`                       // Optional. Service message: user boosted the chat
+	ChatBackgroundSet             *ChatBackground                `json:"chat_background_set,omitempty"`               // Optional. Service message: chat background set
+	ForumTopicCreated             *ForumTopicCreated             `json:"forum_topic_created,omitempty"`               // Optional. Service message: forum topic created
+	ForumTopicEdited              *ForumTopicEdited              `json:"forum_topic_edited,omitempty"`                // Optional. Service message: forum topic edited
+	ForumTopicClosed              *ForumTopicClosed              `json:"forum_topic_closed,omitempty"`                // Optional. Service message: forum topic closed
+	ForumTopicReopened            *ForumTopicReopened            `json:"forum_topic_reopened,omitempty"`              // Optional. Service message: forum topic reopened
+	GeneralForumTopicHidden       *GeneralForumTopicHidden       `json:"general_forum_topic_hidden,omitempty"`        // Optional. Service message: the 'General' forum topic hidden
+	GeneralForumTopicUnhidden     *GeneralForumTopicUnhidden     `json:"general_forum_topic_unhidden,omitempty"`      // Optional. Service message: the 'General' forum topic unhidden
+	GiveawayCreated               *GiveawayCreated               `json:"giveaway_created,omitempty"`                  // Optional. Service message: a scheduled giveaway was created
+	GiveawayCompleted             *GiveawayCompleted             `json:"giveaway_completed,omitempty"`                // Optional. Service message: a giveaway without public winners was completed
+	VideoChatScheduled            *VideoChatScheduled            `json:"video_chat_scheduled,omitempty"`              // Optional. Service message: video chat scheduled
+	VideoChatStarted              *VideoChatStarted              `json:"video_chat_started,omitempty"`                // Optional. Service message: video chat started
+	VideoChatEnded                *VideoChatEnded                `json:"video_chat_ended,omitempty"`                  // Optional. Service message: video chat ended
+	VideoChatParticipantsInvited  *VideoChatParticipantsInvited  `json:"video_chat_participants_invited,omitempty"`   // Optional. Service message: new participants invited to a video chat
+	WebAppData                    *WebAppData                    `json:"web_app_data,omitempty"`                      // Optional. Service message: data sent by a Web App
 	ReplyMarkup                   *InlineKeyboardMarkup          `json:"reply_markup,omitempty"`                      // Optional. Inline keyboard attached to the message. login_url buttons are represented as ordinary url buttons.
 }
 
+func (message *Message) UnmarshalJSON(data []byte) error {
+	type plain Message
+	decoded := struct {
+		*plain
+		ForwardOrigin json.RawMessage `json:"forward_origin"`
+	}{plain: (*plain)(message)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	message.ForwardOrigin, err = unmarshalMessageOrigin(decoded.ForwardOrigin)
+	return err
+}
+
 type MessageId struct {
 	MessageId int `json:"message_id"` // Unique message identifier
 }
 
 type MessageEntity struct {
-	Type     string `json:"type"`               // Type of the entity. Can be "mention" (@username), "hashtag" (#hashtag), "cashtag" ($USD), "bot_command" (/start@jobs_bot), "url" (https://telegram.org), "email" (do-not-reply@telegram.org), "phone_number" (+1-212-555-0123), "bold" (bold text), "italic" (italic text), "underline" (underlined text), "strikethrough" (strikethrough text), "code" (monowidth string), "pre" (monowidth block), "text_link" (for clickable text URLs), "text_mention" (for users without usernames)
-	Offset   int    `json:"offset"`             // Offset in UTF-16 code units to the start of the entity
-	Length   int    `json:"length"`             // Length of the entity in UTF-16 code units
-	Url      string `json:"url,omitempty"`      // Optional. For "text_link" only, url that will be opened after user taps on the text
-	User     *User  `json:"user,omitempty"`     // Optional. For "text_mention" only, the mentioned user
-	Language string `json:"language,omitempty"` // Optional. For "pre" only, the programming language of the entity text
+	Type          string `json:"type"`                      // Type of the entity. Currently, can be "mention" (@username), "hashtag" (#hashtag), "cashtag" ($USD), "bot_command" (/start@jobs_bot), "url" (https://telegram.org), "email" (do-not-reply@telegram.org), "phone_number" (+1-212-555-0123), "bold" (bold text), "italic" (italic text), "underline" (underlined text), "strikethrough" (strikethrough text), "spoiler" (spoiler message), "blockquote" (block quotation), "expandable_blockquote" (collapsed-by-default block quotation), "code" (monowidth string), "pre" (monowidth block), "text_link" (for clickable text URLs), "text_mention" (for users without usernames), "custom_emoji" (for inline custom emoji stickers)
+	Offset        int    `json:"offset"`                    // Offset in UTF-16 code units to the start of the entity
+	Length        int    `json:"length"`                    // Length of the entity in UTF-16 code units
+	Url           string `json:"url,omitempty"`             // Optional. For "text_link" only, url that will be opened after user taps on the text
+	User          *User  `json:"user,omitempty"`            // Optional. For "text_mention" only, the mentioned user
+	Language      string `json:"language,omitempty"`        // Optional. For "pre" only, the programming language of the entity text
+	CustomEmojiId string `json:"custom_emoji_id,omitempty"` // Optional. For "custom_emoji" only, unique identifier of the custom emoji. Use getCustomEmojiStickers to get full information about the sticker
+}
+
+type TextQuote struct {
+	Text     string           `json:"text"`                // Text of the quoted part of a message that is replied to by the given message
+	Entities []*MessageEntity `json:"entities,omitempty"`  // Optional. Special entities that appear in the quote. Currently, only bold, italic, underline, strikethrough, spoiler, and custom_emoji entities are kept in quotes.
+	Position int              `json:"position"`            // Approximate quote position in the original message in UTF-16 code units as specified by the sender
+	IsManual bool             `json:"is_manual,omitempty"` // Optional. True, if the quote was chosen manually by the message sender. Otherwise, the quote was added automatically by the server.
+}
+
+type ExternalReplyInfo struct {
+	Origin             MessageOrigin       `json:"origin"`                         // Origin of the message replied to by the given message
+	Chat               *Chat               `json:"chat,omitempty"`                 // Optional. Chat the original message belongs to. Available only if the chat is a supergroup or a channel.
+	MessageId          int                 `json:"message_id,omitempty"`           // Optional. Unique message identifier inside the original chat. Available only if the original chat is a supergroup or a channel.
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"` // Optional. Options used for link preview generation for the original message, if it is a text message
+	Animation          *Animation          `json:"animation,omitempty"`            // Optional. Message is an animation, information about the animation
+	Audio              *Audio              `json:"audio,omitempty"`                // Optional. Message is an audio file, information about the file
+	Document           *Document           `json:"document,omitempty"`             // Optional. Message is a general file, information about the file
+	PaidMedia          *PaidMediaInfo      `json:"paid_media,omitempty"`           // Optional. Message contains paid media; information about the paid media
+	Photo              []*PhotoSize        `json:"photo,omitempty"`                // Optional. Message is a photo, available sizes of the photo
+	Sticker            *Sticker            `json:"sticker,omitempty"`              // Optional. Message is a sticker, information about the sticker
+	Story              *Story              `json:"story,omitempty"`                // Optional. Message is a forwarded story
+	Video              *Video              `json:"video,omitempty"`                // Optional. Message is a video, information about the video
+	VideoNote          *VideoNote          `json:"video_note,omitempty"`           // Optional. Message is a video note, information about the video message
+	Voice              *Voice              `json:"voice,omitempty"`                // Optional. Message is a voice message, information about the file
+	HasMediaSpoiler    bool                `json:"has_media_spoiler,omitempty"`    // Optional. True, if the message media is covered by a spoiler animation
+	Contact            *Contact            `json:"contact,omitempty"`              // Optional. Message is a shared contact, information about the contact
+	Dice               *Dice               `json:"dice,omitempty"`                 // Optional. Message is a dice with random value
+	Game               *Game               `json:"game,omitempty"`                 // Optional. Message is a game, information about the game
+	Giveaway           *Giveaway           `json:"giveaway,omitempty"`             // Optional. Message is a scheduled giveaway, information about the giveaway
+	GiveawayWinners    *GiveawayWinners    `json:"giveaway_winners,omitempty"`     // Optional. A giveaway with public winners was completed
+	Invoice            *Invoice            `json:"invoice,omitempty"`              // Optional. Message is an invoice for a payment, information about the invoice
+	Location           *Location           `json:"location,omitempty"`             // Optional. Message is a shared location, information about the location
+	Poll               *Poll               `json:"poll,omitempty"`                 // Optional. Message is a native poll, information about the poll
+	Venue              *Venue              `json:"venue,omitempty"`                // Optional. Message is a venue, information about the venue
+}
+
+func (externalReplyInfo *ExternalReplyInfo) UnmarshalJSON(data []byte) error {
+	type plain ExternalReplyInfo
+	decoded := struct {
+		*plain
+		Origin json.RawMessage `json:"origin"`
+	}{plain: (*plain)(externalReplyInfo)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	externalReplyInfo.Origin, err = unmarshalMessageOrigin(decoded.Origin)
+	return err
+}
+
+type ReplyParameters struct {
+	MessageId                int              `json:"message_id"`                            // Identifier of the message that will be replied to in the current chat, or in the chat chat_id if it is specified
+	ChatId                   *ChatId          `json:"chat_id,omitempty"`                     // Optional. If the message to be replied to is from a different chat, unique identifier for the chat or username of the channel (in the format @channelusername). Not supported for messages sent on behalf of a business account.
+	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"` // Optional. Pass True if the message should be sent even if the specified message to be replied to is not found. Always False for replies in another chat or forum topic. Always True for messages sent on behalf of a business account.
+	Quote                    string           `json:"quote,omitempty" validate:"len=0-1024"` // Optional. Quoted part of the message to be replied to; 0-1024 characters after entities parsing. The quote must be an exact substring of the message to be replied to, including bold, italic, underline, strikethrough, spoiler, and custom_emoji entities. The message will fail to send if the quote isn't found in the original message.
+	QuoteParseMode           string           `json:"quote_parse_mode,omitempty"`            // Optional. Mode for parsing entities in the quote. See formatting options for more details.
+	QuoteEntities            []*MessageEntity `json:"quote_entities,omitempty"`              // Optional. A JSON-serialized list of special entities that appear in the quote. It can be specified instead of quote_parse_mode.
+	QuotePosition            int              `json:"quote_position,omitempty"`              // Optional. Position of the quote in the original message in UTF-16 code units
+}
+
+type MessageOriginUser struct {
+	Date       int   `json:"date"`        // Date the message was sent originally in Unix time
+	SenderUser *User `json:"sender_user"` // User that sent the message originally
+}
+
+type MessageOriginHiddenUser struct {
+	Date           int    `json:"date"`             // Date the message was sent originally in Unix time
+	SenderUserName string `json:"sender_user_name"` // Name of the user that sent the message originally
+}
+
+type MessageOriginChat struct {
+	Date            int    `json:"date"`                       // Date the message was sent originally in Unix time
+	SenderChat      *Chat  `json:"sender_chat"`                // Chat that sent the message originally
+	AuthorSignature string `json:"author_signature,omitempty"` // Optional. For messages originally sent by an anonymous chat administrator, original message author signature
+}
+
+type MessageOriginChannel struct {
+	Date            int    `json:"date"`                       // Date the message was sent originally in Unix time
+	Chat            *Chat  `json:"chat"`                       // Channel chat to which the message was originally sent
+	MessageId       int    `json:"message_id"`                 // Unique message identifier inside the chat
+	AuthorSignature string `json:"author_signature,omitempty"` // Optional. Signature of the original post author
 }
 
 type PhotoSize struct {
@@ -167,7 +344,7 @@ type Animation struct {
 	Width        int        `json:"width"`               // Video width as defined by sender
 	Height       int        `json:"height"`              // Video height as defined by sender
 	Duration     int        `json:"duration"`            // Duration of the video in seconds as defined by sender
-	Thumb        *PhotoSize `json:"thumb,omitempty"`     // Optional. Animation thumbnail as defined by sender
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"` // Optional. Animation thumbnail as defined by sender
 	FileName     string     `json:"file_name,omitempty"` // Optional. Original animation filename as defined by sender
 	MimeType     string     `json:"mime_type,omitempty"` // Optional. MIME type of the file as defined by sender
 	FileSize     int        `json:"file_size,omitempty"` // Optional. File size
@@ -182,13 +359,13 @@ type Audio struct {
 	FileName     string     `json:"file_name,omitempty"` // Optional. Original filename as defined by sender
 	MimeType     string     `json:"mime_type,omitempty"` // Optional. MIME type of the file as defined by sender
 	FileSize     int        `json:"file_size,omitempty"` // Optional. File size
-	Thumb        *PhotoSize `json:"thumb,omitempty"`     // Optional. Thumbnail of the album cover to which the music file belongs
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"` // Optional. Thumbnail of the album cover to which the music file belongs
 }
 
 type Document struct {
 	FileId       string     `json:"file_id"`             // Identifier for this file, which can be used to download or reuse the file
 	FileUniqueId string     `json:"file_unique_id"`      // Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
-	Thumb        *PhotoSize `json:"thumb,omitempty"`     // Optional. Document thumbnail as defined by sender
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"` // Optional. Document thumbnail as defined by sender
 	FileName     string     `json:"file_name,omitempty"` // Optional. Original filename as defined by sender
 	MimeType     string     `json:"mime_type,omitempty"` // Optional. MIME type of the file as defined by sender
 	FileSize     int        `json:"file_size,omitempty"` // Optional. File size
@@ -200,7 +377,7 @@ type Video struct {
 	Width        int        `json:"width"`               // Video width as defined by sender
 	Height       int        `json:"height"`              // Video height as defined by sender
 	Duration     int        `json:"duration"`            // Duration of the video in seconds as defined by sender
-	Thumb        *PhotoSize `json:"thumb,omitempty"`     // Optional. Video thumbnail
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"` // Optional. Video thumbnail
 	FileName     string     `json:"file_name,omitempty"` // Optional. Original filename as defined by sender
 	MimeType     string     `json:"mime_type,omitempty"` // Optional. Mime type of a file as defined by sender
 	FileSize     int        `json:"file_size,omitempty"` // Optional. File size
@@ -211,10 +388,44 @@ type VideoNote struct {
 	FileUniqueId string     `json:"file_unique_id"`      // Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
 	Length       int        `json:"length"`              // Video width and height (diameter of the video message) as defined by sender
 	Duration     int        `json:"duration"`            // Duration of the video in seconds as defined by sender
-	Thumb        *PhotoSize `json:"thumb,omitempty"`     // Optional. Video thumbnail
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"` // Optional. Video thumbnail
 	FileSize     int        `json:"file_size,omitempty"` // Optional. File size
 }
 
+type PaidMediaInfo struct {
+	StarCount int         `json:"star_count"` // The number of Telegram Stars that must be paid to buy access to the media
+	PaidMedia []PaidMedia `json:"paid_media"` // Information about the paid media
+}
+
+func (paidMediaInfo *PaidMediaInfo) UnmarshalJSON(data []byte) error {
+	type plain PaidMediaInfo
+	decoded := struct {
+		*plain
+		PaidMedia json.RawMessage `json:"paid_media"`
+	}{plain: (*plain)(paidMediaInfo)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	paidMediaInfo.PaidMedia, err = unmarshalPaidMedias(decoded.PaidMedia)
+	return err
+}
+
+type PaidMediaPreview struct {
+	Width    int `json:"width,omitempty"`    // Optional. Media width as defined by the sender
+	Height   int `json:"height,omitempty"`   // Optional. Media height as defined by the sender
+	Duration int `json:"duration,omitempty"` // Optional. Duration of the media in seconds as defined by the sender
+}
+
+type PaidMediaPhoto struct {
+	Photo []*PhotoSize `json:"photo"` // The photo
+}
+
+type PaidMediaVideo struct {
+	Video *Video `json:"video"` // The video
+}
+
 type Voice struct {
 	FileId       string `json:"file_id"`             // Identifier for this file, which can be used to download or reuse the file
 	FileUniqueId string `json:"file_unique_id"`      // Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
@@ -237,19 +448,28 @@ type Dice struct {
 }
 
 type PollOption struct {
-	Text       string `json:"text"`        // Option text, 1-100 characters
-	VoterCount int    `json:"voter_count"` // Number of users that voted for this option
+	Text         string           `json:"text"`                    // Option text, 1-100 characters
+	TextEntities []*MessageEntity `json:"text_entities,omitempty"` // Optional. Special entities that appear in the option text. Currently, only custom emoji entities are allowed in poll option texts
+	VoterCount   int              `json:"voter_count"`             // Number of users that voted for this option
+}
+
+type InputPollOption struct {
+	Text          string           `json:"text" validate:"len=1-100"` // Option text, 1-100 characters
+	TextParseMode string           `json:"text_parse_mode,omitempty"` // Optional. Mode for parsing entities in the text. See formatting options for more details. Currently, only custom emoji entities are allowed
+	TextEntities  []*MessageEntity `json:"text_entities,omitempty"`   // Optional. A JSON-serialized list of special entities that appear in the poll option text. It can be specified instead of text_parse_mode
 }
 
 type PollAnswer struct {
-	PollId    string `json:"poll_id"`    // Unique poll identifier
-	User      *User  `json:"user"`       // The user, who changed the answer to the poll
-	OptionIds []int  `json:"option_ids"` // 0-based identifiers of answer options, chosen by the user. May be empty if the user retracted their vote.
+	PollId    string `json:"poll_id"`              // Unique poll identifier
+	VoterChat *Chat  `json:"voter_chat,omitempty"` // Optional. The chat that changed the answer to the poll, if the voter is anonymous
+	User      *User  `json:"user,omitempty"`       // Optional. The user that changed the answer to the poll, if the voter isn't anonymous
+	OptionIds []int  `json:"option_ids"`           // 0-based identifiers of answer options, chosen by the user. May be empty if the user retracted their vote.
 }
 
 type Poll struct {
 	Id                    string           `json:"id"`                             // Unique poll identifier
 	Question              string           `json:"question"`                       // Poll question, 1-300 characters
+	QuestionEntities      []*MessageEntity `json:"question_entities,omitempty"`    // Optional. Special entities that appear in the question. Currently, only custom emoji entities are allowed in poll questions
 	Options               []*PollOption    `json:"options"`                        // List of poll options
 	TotalVoterCount       int              `json:"total_voter_count"`              // Total number of users that voted in the poll
 	IsClosed              bool             `json:"is_closed"`                      // True, if the poll is closed
@@ -288,23 +508,215 @@ type ProximityAlertTriggered struct {
 	Distance int   `json:"distance"` // The distance between the users
 }
 
+type ChatBoostAdded struct {
+	BoostCount int `json:"boost_count"` // Number of boosts added by the user
+}
+
+type BackgroundFillSolid struct {
+	Color int `json:"color"` // The color of the background fill in the RGB24 format
+}
+
+type BackgroundFillGradient struct {
+	TopColor      int `json:"top_color"`      // Top color of the gradient in the RGB24 format
+	BottomColor   int `json:"bottom_color"`   // Bottom color of the gradient in the RGB24 format
+	RotationAngle int `json:"rotation_angle"` // Clockwise rotation angle of the background fill in degrees; 0-359
+}
+
+type BackgroundFillFreeformGradient struct {
+	Colors []int `json:"colors"` // A list of the 3 or 4 base colors that are used to generate the freeform gradient in the RGB24 format
+}
+
+type BackgroundTypeFill struct {
+	Fill             BackgroundFill `json:"fill"`               // The background fill
+	DarkThemeDimming int            `json:"dark_theme_dimming"` // Dimming of the background in dark themes, as a percentage; 0-100
+}
+
+func (backgroundTypeFill *BackgroundTypeFill) UnmarshalJSON(data []byte) error {
+	type plain BackgroundTypeFill
+	decoded := struct {
+		*plain
+		Fill json.RawMessage `json:"fill"`
+	}{plain: (*plain)(backgroundTypeFill)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	backgroundTypeFill.Fill, err = unmarshalBackgroundFill(decoded.Fill)
+	return err
+}
+
+type BackgroundTypeWallpaper struct {
+	Document         *Document `json:"document"`             // Document with the wallpaper
+	DarkThemeDimming int       `json:"dark_theme_dimming"`   // Dimming of the background in dark themes, as a percentage; 0-100
+	IsBlurred        bool      `json:"is_blurred,omitempty"` // Optional. True, if the wallpaper is downscaled to fit in a 450x450 square and then box-blurred with radius 12
+	IsMoving         bool      `json:"is_moving,omitempty"`  // Optional. True, if the background moves slightly when the device is tilted
+}
+
+type BackgroundTypePattern struct {
+	Document   *Document      `json:"document"`              // Document with the pattern
+	Fill       BackgroundFill `json:"fill"`                  // The background fill that is combined with the pattern
+	Intensity  int            `json:"intensity"`             // Intensity of the pattern when it is shown above the filled background; 0-100
+	IsInverted bool           `json:"is_inverted,omitempty"` // Optional. True, if the background fill must be applied only to the pattern itself. All other pixels are black in this case. For dark themes only
+	IsMoving   bool           `json:"is_moving,omitempty"`   // Optional. True, if the background moves slightly when the device is tilted
+}
+
+func (backgroundTypePattern *BackgroundTypePattern) UnmarshalJSON(data []byte) error {
+	type plain BackgroundTypePattern
+	decoded := struct {
+		*plain
+		Fill json.RawMessage `json:"fill"`
+	}{plain: (*plain)(backgroundTypePattern)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	backgroundTypePattern.Fill, err = unmarshalBackgroundFill(decoded.Fill)
+	return err
+}
+
+type BackgroundTypeChatTheme struct {
+	ThemeName string `json:"theme_name"` // Name of the chat theme, which is usually an emoji
+}
+
+type ChatBackground struct {
+	Type BackgroundType `json:"type"` // Type of the background
+}
+
+func (chatBackground *ChatBackground) UnmarshalJSON(data []byte) error {
+	type plain ChatBackground
+	decoded := struct {
+		*plain
+		Type json.RawMessage `json:"type"`
+	}{plain: (*plain)(chatBackground)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	chatBackground.Type, err = unmarshalBackgroundType(decoded.Type)
+	return err
+}
+
+type ForumTopicCreated struct {
+	Name              string `json:"name"`                           // Name of the topic
+	IconColor         int    `json:"icon_color"`                     // Color of the topic icon in RGB format
+	IconCustomEmojiId string `json:"icon_custom_emoji_id,omitempty"` // Optional. Unique identifier of the custom emoji shown as the topic icon
+}
+
+type ForumTopicClosed struct {
+}
+
+type ForumTopicEdited struct {
+	Name              string `json:"name,omitempty"`                 // Optional. New name of the topic, if it was edited
+	IconCustomEmojiId string `json:"icon_custom_emoji_id,omitempty"` // Optional. New identifier of the custom emoji shown as the topic icon, if it was edited; an empty string if the icon was removed
+}
+
+type ForumTopicReopened struct {
+}
+
+type GeneralForumTopicHidden struct {
+}
+
+type GeneralForumTopicUnhidden struct {
+}
+
+type SharedUser struct {
+	UserId    int          `json:"user_id"`              // Identifier of the shared user
+	FirstName string       `json:"first_name,omitempty"` // Optional. First name of the user, if the name was requested by the bot
+	LastName  string       `json:"last_name,omitempty"`  // Optional. Last name of the user, if the name was requested by the bot
+	Username  string       `json:"username,omitempty"`   // Optional. Username of the user, if the username was requested by the bot
+	Photo     []*PhotoSize `json:"photo,omitempty"`      // Optional. Available sizes of the chat photo, if the photo was requested by the bot
+}
+
+type UsersShared struct {
+	RequestId int           `json:"request_id"` // Identifier of the request
+	Users     []*SharedUser `json:"users"`      // Information about users shared with the bot
+}
+
+type ChatShared struct {
+	RequestId int          `json:"request_id"`         // Identifier of the request
+	ChatId    int          `json:"chat_id"`            // Identifier of the shared chat
+	Title     string       `json:"title,omitempty"`    // Optional. Title of the chat, if the title was requested by the bot
+	Username  string       `json:"username,omitempty"` // Optional. Username of the chat, if the username was requested by the bot and available
+	Photo     []*PhotoSize `json:"photo,omitempty"`    // Optional. Available sizes of the chat photo, if the photo was requested by the bot
+}
+
+type WriteAccessAllowed struct {
+	FromRequest        bool   `json:"from_request,omitempty"`         // Optional. True, if the access was granted after the user accepted an explicit request from a Web App sent by the method requestWriteAccess
+	WebAppName         string `json:"web_app_name,omitempty"`         // Optional. Name of the Web App, if the access was granted when the Web App was launched from a link
+	FromAttachmentMenu bool   `json:"from_attachment_menu,omitempty"` // Optional. True, if the access was granted when the bot was added to the attachment or side menu
+}
+
 type MessageAutoDeleteTimerChanged struct {
 	MessageAutoDeleteTime int `json:"message_auto_delete_time"` // New auto-delete time for messages in the chat
 }
 
-type VoiceChatScheduled struct {
-	StartDate int `json:"start_date"` // Point in time (Unix timestamp) when the voice chat is supposed to be started by a chat administrator
+type VideoChatScheduled struct {
+	StartDate int `json:"start_date"` // Point in time (Unix timestamp) when the video chat is supposed to be started by a chat administrator
+}
+
+type VideoChatStarted struct {
+}
+
+type VideoChatEnded struct {
+	Duration int `json:"duration"` // Video chat duration in seconds
+}
+
+type VideoChatParticipantsInvited struct {
+	Users []*User `json:"users,omitempty"` // Optional. New members that were invited to the video chat
+}
+
+type Story struct {
+	Chat *Chat `json:"chat"` // Chat that posted the story
+	Id   int   `json:"id"`   // Unique identifier for the story in the chat
 }
 
-type VoiceChatStarted struct {
+type GiveawayCreated struct {
+	PrizeStarCount int `json:"prize_star_count,omitempty"` // Optional. The number of Telegram Stars to be split between giveaway winners; for Telegram Star giveaways only
 }
 
-type VoiceChatEnded struct {
-	Duration int `json:"duration"` // Voice chat duration; in seconds
+type Giveaway struct {
+	Chats                         []*Chat  `json:"chats"`                                      // The list of chats which the user must join to participate in the giveaway
+	WinnersSelectionDate          int      `json:"winners_selection_date"`                     // Point in time (Unix timestamp) when winners of the giveaway will be selected
+	WinnerCount                   int      `json:"winner_count"`                               // The number of users which are supposed to be selected as winners of the giveaway
+	OnlyNewMembers                bool     `json:"only_new_members,omitempty"`                 // Optional. True, if only users who join the chats after the giveaway started should be eligible to win
+	HasPublicWinners              bool     `json:"has_public_winners,omitempty"`               // Optional. True, if the list of giveaway winners will be visible to everyone
+	PrizeDescription              string   `json:"prize_description,omitempty"`                // Optional. Description of additional giveaway prize
+	CountryCodes                  []string `json:"country_codes,omitempty"`                    // Optional. A list of two-letter ISO 3166-1 alpha-2 country codes indicating the countries from which eligible users for the giveaway must come. If empty, then all users can participate in the giveaway. Users with a phone number that was bought on Fragment can always participate in giveaways.
+	PrizeStarCount                int      `json:"prize_star_count,omitempty"`                 // Optional. The number of Telegram Stars to be split between giveaway winners; for Telegram Star giveaways only
+	PremiumSubscriptionMonthCount int      `json:"premium_subscription_month_count,omitempty"` // Optional. The number of months the Telegram Premium subscription won from the giveaway will be active for; for Telegram Premium giveaways only
 }
 
-type VoiceChatParticipantsInvited struct {
-	Users []*User `json:"users,omitempty"` // Optional. New members that were invited to the voice chat
+type GiveawayWinners struct {
+	Chat                          *Chat   `json:"chat"`                                       // The chat that created the giveaway
+	GiveawayMessageId             int     `json:"giveaway_message_id"`                        // Identifier of the message with the giveaway in the chat
+	WinnersSelectionDate          int     `json:"winners_selection_date"`                     // Point in time (Unix timestamp) when winners of the giveaway were selected
+	WinnerCount                   int     `json:"winner_count"`                               // Total number of winners in the giveaway
+	Winners                       []*User `json:"winners"`                                    // List of up to 100 winners of the giveaway
+	AdditionalChatCount           int     `json:"additional_chat_count,omitempty"`            // Optional. The number of other chats the user had to join in order to be eligible for the giveaway
+	PrizeStarCount                int     `json:"prize_star_count,omitempty"`                 // Optional. The number of Telegram Stars that were split between giveaway winners; for Telegram Star giveaways only
+	PremiumSubscriptionMonthCount int     `json:"premium_subscription_month_count,omitempty"` // Optional. The number of months the Telegram Premium subscription won from the giveaway will be active for; for Telegram Premium giveaways only
+	UnclaimedPrizeCount           int     `json:"unclaimed_prize_count,omitempty"`            // Optional. Number of undistributed prizes
+	OnlyNewMembers                bool    `json:"only_new_members,omitempty"`                 // Optional. True, if only users who had joined the chats after the giveaway started were eligible to win
+	WasRefunded                   bool    `json:"was_refunded,omitempty"`                     // Optional. True, if the giveaway was canceled because the payment for it was refunded
+	PrizeDescription              string  `json:"prize_description,omitempty"`                // Optional. Description of additional giveaway prize
+}
+
+type GiveawayCompleted struct {
+	WinnerCount         int      `json:"winner_count"`                    // Number of winners in the giveaway
+	UnclaimedPrizeCount int      `json:"unclaimed_prize_count,omitempty"` // Optional. Number of undistributed prizes
+	GiveawayMessage     *Message `json:"giveaway_message,omitempty"`      // Optional. Message with the giveaway that was completed, if it wasn't deleted
+	IsStarGiveaway      bool     `json:"is_star_giveaway,omitempty"`      // Optional. True, if the giveaway is a Telegram Star giveaway. Otherwise, currently, the giveaway is a Telegram Premium giveaway.
+}
+
+type LinkPreviewOptions struct {
+	IsDisabled       bool   `json:"is_disabled,omitempty"`        // Optional. True, if the link preview is disabled
+	Url              string `json:"url,omitempty"`                // Optional. URL to use for the link preview. If empty, then the first URL found in the message text will be used
+	PreferSmallMedia bool   `json:"prefer_small_media,omitempty"` // Optional. True, if the media in the link preview is supposed to be shrunk; ignored if the URL isn't explicitly specified or media size change isn't supported for the preview
+	PreferLargeMedia bool   `json:"prefer_large_media,omitempty"` // Optional. True, if the media in the link preview is supposed to be enlarged; ignored if the URL isn't explicitly specified or media size change isn't supported for the preview
+	ShowAboveText    bool   `json:"show_above_text,omitempty"`    // Optional. True, if the link preview must be shown above the message text; otherwise, the link preview will be shown below the message text
 }
 
 type UserProfilePhotos struct {
@@ -319,8 +731,13 @@ type File struct {
 	FilePath     string `json:"file_path,omitempty"` // Optional. File path. Use https://api.telegram.org/file/bot<token>/<file_path> to get the file.
 }
 
+type WebAppInfo struct {
+	Url string `json:"url"` // An HTTPS URL of a Web App to be opened with additional data as specified in Initializing Web Apps
+}
+
 type ReplyKeyboardMarkup struct {
 	Keyboard              [][]*KeyboardButton `json:"keyboard"`                          // Array of button rows, each represented by an Array of KeyboardButton objects
+	IsPersistent          bool                `json:"is_persistent,omitempty"`           // Optional. Requests clients to always show the keyboard when the regular keyboard is hidden. Defaults to false, in which case the custom keyboard can be hidden and opened with a keyboard icon.
 	ResizeKeyboard        bool                `json:"resize_keyboard,omitempty"`         // Optional. Requests clients to resize the keyboard vertically for optimal fit (e.g., make the keyboard smaller if there are just two rows of buttons). Defaults to false, in which case the custom keyboard is always of the same height as the app's standard keyboard.
 	OneTimeKeyboard       bool                `json:"one_time_keyboard,omitempty"`       // Optional. Requests clients to hide the keyboard as soon as it's been used. The keyboard will still be available, but clients will automatically display the usual letter-keyboard in the chat – the user can press a special button in the input field to see the custom keyboard again. Defaults to false.
 	InputFieldPlaceholder string              `json:"input_field_placeholder,omitempty"` // Optional. The placeholder to be shown in the input field when the keyboard is active; 1-64 characters
@@ -328,10 +745,37 @@ type ReplyKeyboardMarkup struct {
 }
 
 type KeyboardButton struct {
-	Text            string                  `json:"text"`                       // Text of the button. If none of the optional fields are used, it will be sent as a message when the button is pressed
-	RequestContact  bool                    `json:"request_contact,omitempty"`  // Optional. If True, the user's phone number will be sent as a contact when the button is pressed. Available in private chats only
-	RequestLocation bool                    `json:"request_location,omitempty"` // Optional. If True, the user's current location will be sent when the button is pressed. Available in private chats only
-	RequestPoll     *KeyboardButtonPollType `json:"request_poll,omitempty"`     // Optional. If specified, the user will be asked to create a poll and send it to the bot when the button is pressed. Available in private chats only
+	Text            string                      `json:"text"`                       // Text of the button. If none of the optional fields are used, it will be sent as a message when the button is pressed
+	RequestUsers    *KeyboardButtonRequestUsers `json:"request_users,omitempty"`    // Optional. If specified, pressing the button will open a list of suitable users. Identifiers of selected users will be sent to the bot in a "users_shared" service message. Available in private chats only.
+	RequestChat     *KeyboardButtonRequestChat  `json:"request_chat,omitempty"`     // Optional. If specified, pressing the button will open a list of suitable chats. Tapping on a chat will send its identifier to the bot in a "chat_shared" service message. Available in private chats only.
+	RequestContact  bool                        `json:"request_contact,omitempty"`  // Optional. If True, the user's phone number will be sent as a contact when the button is pressed. Available in private chats only
+	RequestLocation bool                        `json:"request_location,omitempty"` // Optional. If True, the user's current location will be sent when the button is pressed. Available in private chats only
+	RequestPoll     *KeyboardButtonPollType     `json:"request_poll,omitempty"`     // Optional. If specified, the user will be asked to create a poll and send it to the bot when the button is pressed. Available in private chats only
+	WebApp          *WebAppInfo                 `json:"web_app,omitempty"`          // Optional. If specified, the described Web App will be launched when the button is pressed. The Web App will be able to send a "web_app_data" service message. Available in private chats only.
+}
+
+type KeyboardButtonRequestUsers struct {
+	RequestId       int  `json:"request_id"`                                   // Signed 32-bit identifier of the request that will be received back in the UsersShared object. Must be unique within the message
+	UserIsBot       bool `json:"user_is_bot,omitempty"`                        // Optional. Pass True to request bots, pass False to request regular users. If not specified, no additional restrictions are applied.
+	UserIsPremium   bool `json:"user_is_premium,omitempty"`                    // Optional. Pass True to request premium users, pass False to request non-premium users. If not specified, no additional restrictions are applied.
+	MaxQuantity     int  `json:"max_quantity,omitempty" validate:"range=1-10"` // Optional. The maximum number of users to be selected; 1-10. Defaults to 1.
+	RequestName     bool `json:"request_name,omitempty"`                       // Optional. Pass True to request the users' first and last names
+	RequestUsername bool `json:"request_username,omitempty"`                   // Optional. Pass True to request the users' usernames
+	RequestPhoto    bool `json:"request_photo,omitempty"`                      // Optional. Pass True to request the users' photos
+}
+
+type KeyboardButtonRequestChat struct {
+	RequestId               int                      `json:"request_id"`                          // Signed 32-bit identifier of the request, which will be received back in the ChatShared object. Must be unique within the message
+	ChatIsChannel           bool                     `json:"chat_is_channel"`                     // Pass True to request a channel chat, pass False to request a group or a supergroup chat.
+	ChatIsForum             bool                     `json:"chat_is_forum,omitempty"`             // Optional. Pass True to request a forum supergroup, pass False to request a non-forum chat. If not specified, no additional restrictions are applied.
+	ChatHasUsername         bool                     `json:"chat_has_username,omitempty"`         // Optional. Pass True to request a supergroup or a channel with a username, pass False to request a chat without a username. If not specified, no additional restrictions are applied.
+	ChatIsCreated           bool                     `json:"chat_is_created,omitempty"`           // Optional. Pass True to request a chat owned by the user. Otherwise, no additional restrictions are applied.
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"` // Optional. A JSON-serialized object listing the required administrator rights of the user in the chat. The rights must be a superset of bot_administrator_rights. If not specified, no additional restrictions are applied.
+	BotAdministratorRights  *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`  // Optional. A JSON-serialized object listing the required administrator rights of the bot in the chat. The rights must be a subset of user_administrator_rights. If not specified, no additional restrictions are applied.
+	BotIsMember             bool                     `json:"bot_is_member,omitempty"`             // Optional. Pass True to request a chat with the bot as a member. Otherwise, no additional restrictions are applied.
+	RequestTitle            bool                     `json:"request_title,omitempty"`             // Optional. Pass True to request the chat's title
+	RequestUsername         bool                     `json:"request_username,omitempty"`          // Optional. Pass True to request the chat's username
+	RequestPhoto            bool                     `json:"request_photo,omitempty"`             // Optional. Pass True to request the chat's photo
 }
 
 type KeyboardButtonPollType struct {
@@ -348,14 +792,16 @@ type InlineKeyboardMarkup struct {
 }
 
 type InlineKeyboardButton struct {
-	Text                         string        `json:"text"`                                       // Label text on the button
-	Url                          string        `json:"url,omitempty"`                              // Optional. HTTP or tg:// url to be opened when button is pressed
-	LoginUrl                     *LoginUrl     `json:"login_url,omitempty"`                        // Optional. An HTTP URL used to automatically authorize the user. Can be used as a replacement for the Telegram Login Widget.
-	CallbackData                 string        `json:"callback_data,omitempty"`                    // Optional. Data to be sent in a callback query to the bot when button is pressed, 1-64 bytes
-	SwitchInlineQuery            string        `json:"switch_inline_query,omitempty"`              // Optional. If set, pressing the button will prompt the user to select one of their chats, open that chat and insert the bot's username and the specified inline query in the input field. Can be empty, in which case just the bot's username will be inserted.Note: This offers an easy way for users to start using your bot in inline mode when they are currently in a private chat with it. Especially useful when combined with switch_pm… actions – in this case the user will be automatically returned to the chat they switched from, skipping the chat selection screen.
-	SwitchInlineQueryCurrentChat string        `json:"switch_inline_query_current_chat,omitempty"` // Optional. If set, pressing the button will insert the bot's username and the specified inline query in the current chat's input field. Can be empty, in which case only the bot's username will be inserted.This offers a quick way for the user to open your bot in inline mode in the same chat – good for selecting something from multiple options.
-	CallbackGame                 *CallbackGame `json:"callback_game,omitempty"`                    // Optional. Description of the game that will be launched when the user presses the button.NOTE: This type of button must always be the first button in the first row.
-	Pay                          bool          `json:"pay,omitempty"`                              // Optional. Specify True, to send a Pay button.NOTE: This type of button must always be the first button in the first row.
+	Text                         string                       `json:"text"`                                          // Label text on the button
+	Url                          string                       `json:"url,omitempty"`                                 // Optional. HTTP or tg:// url to be opened when button is pressed
+	LoginUrl                     *LoginUrl                    `json:"login_url,omitempty"`                           // Optional. An HTTP URL used to automatically authorize the user. Can be used as a replacement for the Telegram Login Widget.
+	CallbackData                 string                       `json:"callback_data,omitempty" validate:"bytes=1-64"` // Optional. Data to be sent in a callback query to the bot when button is pressed, 1-64 bytes
+	WebApp                       *WebAppInfo                  `json:"web_app,omitempty"`                             // Optional. Description of the Web App that will be launched when the user presses the button. The Web App will be able to send an arbitrary message on behalf of the user using the method answerWebAppQuery. Available only in private chats between a user and the bot. Not supported for messages sent on behalf of a Telegram Business account.
+	SwitchInlineQuery            string                       `json:"switch_inline_query,omitempty"`                 // Optional. If set, pressing the button will prompt the user to select one of their chats, open that chat and insert the bot's username and the specified inline query in the input field. Can be empty, in which case just the bot's username will be inserted.Note: This offers an easy way for users to start using your bot in inline mode when they are currently in a private chat with it. Especially useful when combined with switch_pm… actions – in this case the user will be automatically returned to the chat they switched from, skipping the chat selection screen.
+	SwitchInlineQueryCurrentChat string                       `json:"switch_inline_query_current_chat,omitempty"`    // Optional. If set, pressing the button will insert the bot's username and the specified inline query in the current chat's input field. Can be empty, in which case only the bot's username will be inserted.This offers a quick way for the user to open your bot in inline mode in the same chat – good for selecting something from multiple options.
+	SwitchInlineQueryChosenChat  *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat,omitempty"`     // Optional. If set, pressing the button will prompt the user to select one of their chats of the specified type, open that chat and insert the bot's username and the specified inline query in the input field. Not supported for messages sent on behalf of a Telegram Business account.
+	CallbackGame                 *CallbackGame                `json:"callback_game,omitempty"`                       // Optional. Description of the game that will be launched when the user presses the button.NOTE: This type of button must always be the first button in the first row.
+	Pay                          bool                         `json:"pay,omitempty"`                                 // Optional. Specify True, to send a Pay button.NOTE: This type of button must always be the first button in the first row.
 }
 
 type LoginUrl struct {
@@ -365,6 +811,14 @@ type LoginUrl struct {
 	RequestWriteAccess bool   `json:"request_write_access,omitempty"` // Optional. Pass True to request the permission for your bot to send messages to the user.
 }
 
+type SwitchInlineQueryChosenChat struct {
+	Query             string `json:"query,omitempty"`               // Optional. The default inline query to be inserted in the input field. If left empty, only the bot's username will be inserted
+	AllowUserChats    bool   `json:"allow_user_chats,omitempty"`    // Optional. True, if private chats with users can be chosen
+	AllowBotChats     bool   `json:"allow_bot_chats,omitempty"`     // Optional. True, if private chats with bots can be chosen
+	AllowGroupChats   bool   `json:"allow_group_chats,omitempty"`   // Optional. True, if group and supergroup chats can be chosen
+	AllowChannelChats bool   `json:"allow_channel_chats,omitempty"` // Optional. True, if channel chats can be chosen
+}
+
 type CallbackQuery struct {
 	Id              string   `json:"id"`                          // Unique identifier for this query
 	From            *User    `json:"from"`                        // Sender
@@ -389,12 +843,35 @@ type ChatPhoto struct {
 }
 
 type ChatInviteLink struct {
-	InviteLink  string `json:"invite_link"`            // The invite link. If the link was created by another chat administrator, then the second part of the link will be replaced with "…".
-	Creator     *User  `json:"creator"`                // Creator of the link
-	IsPrimary   bool   `json:"is_primary"`             // True, if the link is primary
-	IsRevoked   bool   `json:"is_revoked"`             // True, if the link is revoked
-	ExpireDate  int    `json:"expire_date,omitempty"`  // Optional. Point in time (Unix timestamp) when the link will expire or has been expired
-	MemberLimit int    `json:"member_limit,omitempty"` // Optional. Maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	InviteLink              string `json:"invite_link"`                          // The invite link. If the link was created by another chat administrator, then the second part of the link will be replaced with "…".
+	Creator                 *User  `json:"creator"`                              // Creator of the link
+	CreatesJoinRequest      bool   `json:"creates_join_request"`                 // True, if users joining the chat via the link need to be approved by chat administrators
+	IsPrimary               bool   `json:"is_primary"`                           // True, if the link is primary
+	IsRevoked               bool   `json:"is_revoked"`                           // True, if the link is revoked
+	Name                    string `json:"name,omitempty"`                       // Optional. Invite link name
+	ExpireDate              int    `json:"expire_date,omitempty"`                // Optional. Point in time (Unix timestamp) when the link will expire or has been expired
+	MemberLimit             int    `json:"member_limit,omitempty"`               // Optional. Maximum number of users that can be members of the chat simultaneously after joining the chat via this invite link; 1-99999
+	PendingJoinRequestCount int    `json:"pending_join_request_count,omitempty"` // Optional. Number of pending join requests created using this link
+	SubscriptionPeriod      int    `json:"subscription_period,omitempty"`        // Optional. The number of seconds the subscription will be active for before the next payment
+	SubscriptionPrice       int    `json:"subscription_price,omitempty"`         // Optional. The amount of Telegram Stars a user must pay initially and after each subsequent subscription period to be a member of the chat using the link
+}
+
+type ChatAdministratorRights struct {
+	IsAnonymous         bool `json:"is_anonymous"`                // True, if the user's presence in the chat is hidden
+	CanManageChat       bool `json:"can_manage_chat"`             // True, if the administrator can access the chat event log, get boost list, see hidden supergroup and channel members, report spam messages and ignore slow mode. Implied by any other administrator privilege.
+	CanDeleteMessages   bool `json:"can_delete_messages"`         // True, if the administrator can delete messages of other users
+	CanManageVideoChats bool `json:"can_manage_video_chats"`      // True, if the administrator can manage video chats
+	CanRestrictMembers  bool `json:"can_restrict_members"`        // True, if the administrator can restrict, ban or unban chat members, or access supergroup statistics
+	CanPromoteMembers   bool `json:"can_promote_members"`         // True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that they have promoted, directly or indirectly (promoted by administrators that were appointed by the user)
+	CanChangeInfo       bool `json:"can_change_info"`             // True, if the user is allowed to change the chat title, photo and other settings
+	CanInviteUsers      bool `json:"can_invite_users"`            // True, if the user is allowed to invite new users to the chat
+	CanPostStories      bool `json:"can_post_stories"`            // True, if the administrator can post stories to the chat
+	CanEditStories      bool `json:"can_edit_stories"`            // True, if the administrator can edit stories posted by other users, post stories to the chat page, pin chat stories, and access the chat's story archive
+	CanDeleteStories    bool `json:"can_delete_stories"`          // True, if the administrator can delete stories posted by other users
+	CanPostMessages     bool `json:"can_post_messages,omitempty"` // Optional. True, if the administrator can post messages in the channel, or access channel statistics; for channels only
+	CanEditMessages     bool `json:"can_edit_messages,omitempty"` // Optional. True, if the administrator can edit messages of other users and can pin messages; for channels only
+	CanPinMessages      bool `json:"can_pin_messages,omitempty"`  // Optional. True, if the user is allowed to pin messages; for groups and supergroups only
+	CanManageTopics     bool `json:"can_manage_topics,omitempty"` // Optional. True, if the user is allowed to create, rename, close, and reopen forum topics; for supergroups only
 }
 
 type ChatMemberOwner struct {
@@ -405,26 +882,31 @@ type ChatMemberOwner struct {
 }
 
 type ChatMemberAdministrator struct {
-	Status              string `json:"status"`                 // The member's status in the chat, always "administrator"
-	User                *User  `json:"user"`                   // Information about the user
-	CanBeEdited         bool   `json:"can_be_edited"`          // True, if the bot is allowed to edit administrator privileges of that user
-	CustomTitle         string `json:"custom_title"`           // Custom title for this user
-	IsAnonymous         bool   `json:"is_anonymous"`           // True, if the user's presence in the chat is hidden
-	CanManageChat       bool   `json:"can_manage_chat"`        // True, if the administrator can access the chat event log, chat statistics, message statistics in channels, see channel members, see anonymous administrators in supergroups and ignore slow mode. Implied by any other administrator privilege
-	CanPostMessages     bool   `json:"can_post_messages"`      // True, if the administrator can post in the channel; channels only
-	CanEditMessages     bool   `json:"can_edit_messages"`      // True, if the administrator can edit messages of other users and can pin messages; channels only
-	CanDeleteMessages   bool   `json:"can_delete_messages"`    // True, if the administrator can delete messages of other users
-	CanManageVoiceChats bool   `json:"can_manage_voice_chats"` // True, if the administrator can manage voice chats
-	CanRestrictMembers  bool   `json:"can_restrict_members"`   // True, if the administrator can restrict, ban or unban chat members
-	CanPromoteMembers   bool   `json:"can_promote_members"`    // True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that he has promoted, directly or indirectly (promoted by administrators that were appointed by the user)
-	CanChangeInfo       bool   `json:"can_change_info"`        // True, if the user is allowed to change the chat title, photo and other settings
-	CanInviteUsers      bool   `json:"can_invite_users"`       // True, if the user is allowed to invite new users to the chat
-	CanPinMessages      bool   `json:"can_pin_messages"`       // True, if the user is allowed to pin messages; groups and supergroups only
+	Status              string `json:"status"`                      // The member's status in the chat, always "administrator"
+	User                *User  `json:"user"`                        // Information about the user
+	CanBeEdited         bool   `json:"can_be_edited"`               // True, if the bot is allowed to edit administrator privileges of that user
+	CustomTitle         string `json:"custom_title"`                // Custom title for this user
+	IsAnonymous         bool   `json:"is_anonymous"`                // True, if the user's presence in the chat is hidden
+	CanManageChat       bool   `json:"can_manage_chat"`             // True, if the administrator can access the chat event log, chat statistics, message statistics in channels, see channel members, see anonymous administrators in supergroups and ignore slow mode. Implied by any other administrator privilege
+	CanPostMessages     bool   `json:"can_post_messages"`           // True, if the administrator can post in the channel; channels only
+	CanEditMessages     bool   `json:"can_edit_messages"`           // True, if the administrator can edit messages of other users and can pin messages; channels only
+	CanDeleteMessages   bool   `json:"can_delete_messages"`         // True, if the administrator can delete messages of other users
+	CanManageVideoChats bool   `json:"can_manage_video_chats"`      // True, if the administrator can manage video chats
+	CanRestrictMembers  bool   `json:"can_restrict_members"`        // True, if the administrator can restrict, ban or unban chat members
+	CanPromoteMembers   bool   `json:"can_promote_members"`         // True, if the administrator can add new administrators with a subset of their own privileges or demote administrators that he has promoted, directly or indirectly (promoted by administrators that were appointed by the user)
+	CanChangeInfo       bool   `json:"can_change_info"`             // True, if the user is allowed to change the chat title, photo and other settings
+	CanInviteUsers      bool   `json:"can_invite_users"`            // True, if the user is allowed to invite new users to the chat
+	CanPostStories      bool   `json:"can_post_stories"`            // True, if the administrator can post stories to the chat
+	CanEditStories      bool   `json:"can_edit_stories"`            // True, if the administrator can edit stories posted by other users, post stories to the chat page, pin chat stories, and access the chat's story archive
+	CanDeleteStories    bool   `json:"can_delete_stories"`          // True, if the administrator can delete stories posted by other users
+	CanPinMessages      bool   `json:"can_pin_messages"`            // True, if the user is allowed to pin messages; groups and supergroups only
+	CanManageTopics     bool   `json:"can_manage_topics,omitempty"` // Optional. True, if the user is allowed to create, rename, close, and reopen forum topics; for supergroups only
 }
 
 type ChatMemberMember struct {
-	Status string `json:"status"` // The member's status in the chat, always "member"
-	User   *User  `json:"user"`   // Information about the user
+	Status    string `json:"status"`               // The member's status in the chat, always "member"
+	User      *User  `json:"user"`                 // Information about the user
+	UntilDate int    `json:"until_date,omitempty"` // Optional. Date when the user's subscription will expire; Unix time
 }
 
 type ChatMemberRestricted struct {
@@ -434,8 +916,14 @@ type ChatMemberRestricted struct {
 	CanChangeInfo         bool   `json:"can_change_info"`           // True, if the user is allowed to change the chat title, photo and other settings
 	CanInviteUsers        bool   `json:"can_invite_users"`          // True, if the user is allowed to invite new users to the chat
 	CanPinMessages        bool   `json:"can_pin_messages"`          // True, if the user is allowed to pin messages; groups and supergroups only
+	CanManageTopics       bool   `json:"can_manage_topics"`         // True, if the user is allowed to create forum topics
 	CanSendMessages       bool   `json:"can_send_messages"`         // True, if the user is allowed to send text messages, contacts, locations and venues
-	CanSendMediaMessages  bool   `json:"can_send_media_messages"`   // True, if the user is allowed to send audios, documents, photos, videos, video notes and voice notes
+	CanSendAudios         bool   `json:"can_send_audios"`           // True, if the user is allowed to send audios
+	CanSendDocuments      bool   `json:"can_send_documents"`        // True, if the user is allowed to send documents
+	CanSendPhotos         bool   `json:"can_send_photos"`           // True, if the user is allowed to send photos
+	CanSendVideos         bool   `json:"can_send_videos"`           // True, if the user is allowed to send videos
+	CanSendVideoNotes     bool   `json:"can_send_video_notes"`      // True, if the user is allowed to send video notes
+	CanSendVoiceNotes     bool   `json:"can_send_voice_notes"`      // True, if the user is allowed to send voice notes
 	CanSendPolls          bool   `json:"can_send_polls"`            // True, if the user is allowed to send polls
 	CanSendOtherMessages  bool   `json:"can_send_other_messages"`   // True, if the user is allowed to send animations, games, stickers and use inline bots
 	CanAddWebPagePreviews bool   `json:"can_add_web_page_previews"` // True, if the user is allowed to add web page previews to their messages
@@ -454,23 +942,67 @@ type ChatMemberBanned struct {
 }
 
 type ChatMemberUpdated struct {
-	Chat          *Chat           `json:"chat"`                  // Chat the user belongs to
-	From          *User           `json:"from"`                  // Performer of the action, which resulted in the change
-	Date          int             `json:"date"`                  // Date the change was done in Unix time
-	OldChatMember interface{}     `json:"old_chat_member"`       // Previous information about the chat member
-	NewChatMember interface{}     `json:"new_chat_member"`       // New information about the chat member
-	InviteLink    *ChatInviteLink `json:"invite_link,omitempty"` // Optional. Chat invite link, which was used by the user to join the chat; for joining by invite link events only.
+	Chat                    *Chat           `json:"chat"`                                  // Chat the user belongs to
+	From                    *User           `json:"from"`                                  // Performer of the action, which resulted in the change
+	Date                    int             `json:"date"`                                  // Date the change was done in Unix time
+	OldChatMember           ChatMember      `json:"old_chat_member"`                       // Previous information about the chat member
+	NewChatMember           ChatMember      `json:"new_chat_member"`                       // New information about the chat member
+	InviteLink              *ChatInviteLink `json:"invite_link,omitempty"`                 // Optional. Chat invite link, which was used by the user to join the chat; for joining by invite link events only.
+	ViaJoinRequest          bool            `json:"via_join_request,omitempty"`            // Optional. True, if the user joined the chat after sending a direct join request without using an invite link and being approved by an administrator
+	ViaChatFolderInviteLink bool            `json:"via_chat_folder_invite_link,omitempty"` // Optional. True, if the user joined the chat via a chat folder invite link
+}
+
+type ChatJoinRequest struct {
+	Chat       *Chat           `json:"chat"`                  // Chat to which the request was sent
+	From       *User           `json:"from"`                  // User that sent the join request
+	UserChatId int             `json:"user_chat_id"`          // Identifier of a private chat with the user who sent the join request. The bot can use this identifier for 5 minutes to send messages until the join request is processed, assuming no other administrator contacted the user.
+	Date       int             `json:"date"`                  // Date the request was sent in Unix time
+	Bio        string          `json:"bio,omitempty"`         // Optional. Bio of the user
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"` // Optional. Chat invite link that was used by the user to send the join request
 }
 
 type ChatPermissions struct {
 	CanSendMessages       bool `json:"can_send_messages,omitempty"`         // Optional. True, if the user is allowed to send text messages, contacts, locations and venues
-	CanSendMediaMessages  bool `json:"can_send_media_messages,omitempty"`   // Optional. True, if the user is allowed to send audios, documents, photos, videos, video notes and voice notes, implies can_send_messages
+	CanSendAudios         bool `json:"can_send_audios,omitempty"`           // Optional. True, if the user is allowed to send audios
+	CanSendDocuments      bool `json:"can_send_documents,omitempty"`        // Optional. True, if the user is allowed to send documents
+	CanSendPhotos         bool `json:"can_send_photos,omitempty"`           // Optional. True, if the user is allowed to send photos
+	CanSendVideos         bool `json:"can_send_videos,omitempty"`           // Optional. True, if the user is allowed to send videos
+	CanSendVideoNotes     bool `json:"can_send_video_notes,omitempty"`      // Optional. True, if the user is allowed to send video notes
+	CanSendVoiceNotes     bool `json:"can_send_voice_notes,omitempty"`      // Optional. True, if the user is allowed to send voice notes
 	CanSendPolls          bool `json:"can_send_polls,omitempty"`            // Optional. True, if the user is allowed to send polls, implies can_send_messages
 	CanSendOtherMessages  bool `json:"can_send_other_messages,omitempty"`   // Optional. True, if the user is allowed to send animations, games, stickers and use inline bots, implies can_send_media_messages
 	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"` // Optional. True, if the user is allowed to add web page previews to their messages, implies can_send_media_messages
 	CanChangeInfo         bool `json:"can_change_info,omitempty"`           // Optional. True, if the user is allowed to change the chat title, photo and other settings. Ignored in public supergroups
 	CanInviteUsers        bool `json:"can_invite_users,omitempty"`          // Optional. True, if the user is allowed to invite new users to the chat
 	CanPinMessages        bool `json:"can_pin_messages,omitempty"`          // Optional. True, if the user is allowed to pin messages. Ignored in public supergroups
+	CanManageTopics       bool `json:"can_manage_topics,omitempty"`         // Optional. True, if the user is allowed to create forum topics. If omitted defaults to the value of can_pin_messages
+}
+
+type Birthdate struct {
+	Day   int `json:"day"`            // Day of the user's birth; 1-31
+	Month int `json:"month"`          // Month of the user's birth; 1-12
+	Year  int `json:"year,omitempty"` // Optional. Year of the user's birth
+}
+
+type BusinessIntro struct {
+	Title   string   `json:"title,omitempty"`   // Optional. Title text of the business intro
+	Message string   `json:"message,omitempty"` // Optional. Message text of the business intro
+	Sticker *Sticker `json:"sticker,omitempty"` // Optional. Sticker of the business intro
+}
+
+type BusinessLocation struct {
+	Address  string    `json:"address"`            // Address of the business
+	Location *Location `json:"location,omitempty"` // Optional. Location of the business
+}
+
+type BusinessOpeningHoursInterval struct {
+	OpeningMinute int `json:"opening_minute"` // The minute's sequence number in a week, starting on Monday, marking the start of the time interval during which the business is open; 0 - 7 * 24 * 60
+	ClosingMinute int `json:"closing_minute"` // The minute's sequence number in a week, starting on Monday, marking the end of the time interval during which the business is open; 0 - 8 * 24 * 60
+}
+
+type BusinessOpeningHours struct {
+	TimeZoneName string                          `json:"time_zone_name"` // Unique name of the time zone for which the opening hours are defined
+	OpeningHours []*BusinessOpeningHoursInterval `json:"opening_hours"`  // List of time intervals describing business opening hours
 }
 
 type ChatLocation struct {
@@ -478,41 +1010,215 @@ type ChatLocation struct {
 	Address  string    `json:"address"`  // Location address; 1-64 characters, as defined by the chat owner
 }
 
+type ReactionTypeEmoji struct {
+	Emoji string `json:"emoji"` // Reaction emoji. Currently, it can be one of "👍", "👎", "❤", "🔥", "🥰", "👏", "😁", "🤔", "🤯", "😱", "🤬", "😢", "🎉", "🤩", "🤮", "💩", "🙏", "👌", "🕊", "🤡", "🥱", "🥴", "😍", "🐳", "❤‍🔥", "🌚", "🌭", "💯", "🤣", "⚡", "🍌", "🏆", "💔", "🤨", "😐", "🍓", "🍾", "💋", "🖕", "😈", "😴", "😭", "🤓", "👻", "👨‍💻", "👀", "🎃", "🙈", "😇", "😨", "🤝", "✍", "🤗", "🫡", "🎅", "🎄", "☃", "💅", "🤪", "🗿", "🆒", "💘", "🙉", "🦄", "😘", "💊", "🙊", "😎", "👾", "🤷‍♂", "🤷", "🤷‍♀", "😡"
+}
+
+type ReactionTypeCustomEmoji struct {
+	CustomEmojiId string `json:"custom_emoji_id"` // Custom emoji identifier
+}
+
+type ReactionTypePaid struct {
+}
+
+type ReactionCount struct {
+	Type       ReactionType `json:"type"`        // Type of the reaction
+	TotalCount int          `json:"total_count"` // Number of times the reaction was added
+}
+
+func (reactionCount *ReactionCount) UnmarshalJSON(data []byte) error {
+	type plain ReactionCount
+	decoded := struct {
+		*plain
+		Type json.RawMessage `json:"type"`
+	}{plain: (*plain)(reactionCount)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	reactionCount.Type, err = unmarshalReactionType(decoded.Type)
+	return err
+}
+
+type MessageReactionUpdated struct {
+	Chat        *Chat          `json:"chat"`                 // The chat containing the message the user reacted to
+	MessageId   int            `json:"message_id"`           // Unique identifier of the message inside the chat
+	User        *User          `json:"user,omitempty"`       // Optional. The user that changed the reaction, if the user isn't anonymous
+	ActorChat   *Chat          `json:"actor_chat,omitempty"` // Optional. The chat on behalf of which the reaction was changed, if the user is anonymous
+	Date        int            `json:"date"`                 // Date of the change in Unix time
+	OldReaction []ReactionType `json:"old_reaction"`         // Previous list of reaction types that were set by the user
+	NewReaction []ReactionType `json:"new_reaction"`         // New list of reaction types that have been set by the user
+}
+
+func (messageReactionUpdated *MessageReactionUpdated) UnmarshalJSON(data []byte) error {
+	type plain MessageReactionUpdated
+	decoded := struct {
+		*plain
+		OldReaction json.RawMessage `json:"old_reaction"`
+		NewReaction json.RawMessage `json:"new_reaction"`
+	}{plain: (*plain)(messageReactionUpdated)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+
+	if messageReactionUpdated.OldReaction, err = unmarshalReactionTypes(decoded.OldReaction); err != nil {
+		return err
+	}
+	messageReactionUpdated.NewReaction, err = unmarshalReactionTypes(decoded.NewReaction)
+	return err
+}
+
+type MessageReactionCountUpdated struct {
+	Chat      *Chat            `json:"chat"`       // The chat containing the message
+	MessageId int              `json:"message_id"` // Unique message identifier inside the chat
+	Date      int              `json:"date"`       // Date of the change in Unix time
+	Reactions []*ReactionCount `json:"reactions"`  // List of reactions that are present on the message
+}
+
+type ForumTopic struct {
+	MessageThreadId   int    `json:"message_thread_id"`              // Unique identifier of the forum topic
+	Name              string `json:"name"`                           // Name of the topic
+	IconColor         int    `json:"icon_color"`                     // Color of the topic icon in RGB format
+	IconCustomEmojiId string `json:"icon_custom_emoji_id,omitempty"` // Optional. Unique identifier of the custom emoji shown as the topic icon
+}
+
 type BotCommand struct {
-	Command     string `json:"command"`     // Text of the command, 1-32 characters. Can contain only lowercase English letters, digits and underscores.
-	Description string `json:"description"` // Description of the command, 3-256 characters.
+	Command     string `json:"command" validate:"len=1-32"`      // Text of the command, 1-32 characters. Can contain only lowercase English letters, digits and underscores.
+	Description string `json:"description" validate:"len=1-256"` // Description of the command, 3-256 characters.
 }
 
 type BotCommandScopeDefault struct {
-	Type string `json:"type"` // Scope type, must be default
 }
 
 type BotCommandScopeAllPrivateChats struct {
-	Type string `json:"type"` // Scope type, must be all_private_chats
 }
 
 type BotCommandScopeAllGroupChats struct {
-	Type string `json:"type"` // Scope type, must be all_group_chats
 }
 
 type BotCommandScopeAllChatAdministrators struct {
-	Type string `json:"type"` // Scope type, must be all_chat_administrators
 }
 
 type BotCommandScopeChat struct {
-	Type   string      `json:"type"`    // Scope type, must be chat
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
 }
 
 type BotCommandScopeChatAdministrators struct {
-	Type   string      `json:"type"`    // Scope type, must be chat_administrators
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
 }
 
 type BotCommandScopeChatMember struct {
-	Type   string      `json:"type"`    // Scope type, must be chat_member
-	ChatId interface{} `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	UserId int         `json:"user_id"` // Unique identifier of the target user
+	ChatId *ChatId `json:"chat_id"` // Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	UserId int     `json:"user_id"` // Unique identifier of the target user
+}
+
+type BotName struct {
+	Name string `json:"name"` // The bot's name
+}
+
+type BotDescription struct {
+	Description string `json:"description"` // The bot's description
+}
+
+type BotShortDescription struct {
+	ShortDescription string `json:"short_description"` // The bot's short description
+}
+
+type MenuButtonCommands struct {
+}
+
+type MenuButtonWebApp struct {
+	Text   string      `json:"text"`    // Text on the button
+	WebApp *WebAppInfo `json:"web_app"` // Description of the Web App that will be launched when the user presses the button. The Web App will be able to send an arbitrary message on behalf of the user using the method answerWebAppQuery. Alternatively, a t.me link to a Web App of the bot can be specified in the object instead of the Web App's URL, in which case the Web App will be opened as if the user pressed the link.
+}
+
+type MenuButtonDefault struct {
+}
+
+type ChatBoostSourcePremium struct {
+	User *User `json:"user"` // User that boosted the chat
+}
+
+type ChatBoostSourceGiftCode struct {
+	User *User `json:"user"` // User for which the gift code was created
+}
+
+type ChatBoostSourceGiveaway struct {
+	GiveawayMessageId int   `json:"giveaway_message_id"`        // Identifier of a message in the chat with the giveaway; the message could have been deleted already. May be 0 if the message isn't sent yet.
+	User              *User `json:"user,omitempty"`             // Optional. User that won the prize in the giveaway if any; for Telegram Premium giveaways only
+	PrizeStarCount    int   `json:"prize_star_count,omitempty"` // Optional. The number of Telegram Stars to be split between giveaway winners; for Telegram Star giveaways only
+	IsUnclaimed       bool  `json:"is_unclaimed,omitempty"`     // Optional. True, if the giveaway was completed, but there was no user to win the prize
+}
+
+type ChatBoost struct {
+	BoostId        string          `json:"boost_id"`        // Unique identifier of the boost
+	AddDate        int             `json:"add_date"`        // Point in time (Unix timestamp) when the chat was boosted
+	ExpirationDate int             `json:"expiration_date"` // Point in time (Unix timestamp) when the boost will automatically expire, unless the booster's Telegram Premium subscription is prolonged
+	Source         ChatBoostSource `json:"source"`          // Source of the added boost
+}
+
+func (chatBoost *ChatBoost) UnmarshalJSON(data []byte) error {
+	type plain ChatBoost
+	decoded := struct {
+		*plain
+		Source json.RawMessage `json:"source"`
+	}{plain: (*plain)(chatBoost)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	chatBoost.Source, err = unmarshalChatBoostSource(decoded.Source)
+	return err
+}
+
+type ChatBoostUpdated struct {
+	Chat  *Chat      `json:"chat"`  // Chat which was boosted
+	Boost *ChatBoost `json:"boost"` // Information about the chat boost
+}
+
+type ChatBoostRemoved struct {
+	Chat       *Chat           `json:"chat"`        // Chat which was boosted
+	BoostId    string          `json:"boost_id"`    // Unique identifier of the boost
+	RemoveDate int             `json:"remove_date"` // Point in time (Unix timestamp) when the boost was removed
+	Source     ChatBoostSource `json:"source"`      // Source of the removed boost
+}
+
+func (chatBoostRemoved *ChatBoostRemoved) UnmarshalJSON(data []byte) error {
+	type plain ChatBoostRemoved
+	decoded := struct {
+		*plain
+		Source json.RawMessage `json:"source"`
+	}{plain: (*plain)(chatBoostRemoved)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	chatBoostRemoved.Source, err = unmarshalChatBoostSource(decoded.Source)
+	return err
+}
+
+type UserChatBoosts struct {
+	Boosts []*ChatBoost `json:"boosts"` // The list of boosts added to the chat by the user
+}
+
+type BusinessConnection struct {
+	Id         string `json:"id"`           // Unique identifier of the business connection
+	User       *User  `json:"user"`         // Business account user that created the business connection
+	UserChatId int    `json:"user_chat_id"` // Identifier of a private chat with the user who created the business connection. This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a 64-bit integer or double-precision float type are safe for storing this identifier.
+	Date       int    `json:"date"`         // Date the connection was established in Unix time
+	CanReply   bool   `json:"can_reply"`    // True, if the bot can act on behalf of the business account in chats that were active in the last 24 hours
+	IsEnabled  bool   `json:"is_enabled"`   // True, if the connection is active
+}
+
+type BusinessMessagesDeleted struct {
+	BusinessConnectionId string `json:"business_connection_id"` // Unique identifier of the business connection
+	Chat                 *Chat  `json:"chat"`                   // Information about a chat in the business account. The bot may not have access to the chat or the corresponding user.
+	MessageIds           []int  `json:"message_ids"`            // The list of identifiers of deleted messages in the chat of the business account
 }
 
 type ResponseParameters struct {
@@ -521,80 +1227,98 @@ type ResponseParameters struct {
 }
 
 type InputMediaPhoto struct {
-	Type            string           `json:"type"`                       // Type of the result, must be photo
-	Media           string           `json:"media"`                      // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
-	Caption         string           `json:"caption,omitempty"`          // Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
-	ParseMode       string           `json:"parse_mode,omitempty"`       // Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
-	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"` // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	Media                 string           `json:"media"`                                   // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
+	Caption               string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
+	ParseMode             string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`                   // Optional. Pass True if the photo needs to be covered with a spoiler animation
 }
 
 type InputMediaVideo struct {
-	Type              string           `json:"type"`                         // Type of the result, must be video
-	Media             string           `json:"media"`                        // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
-	Thumb             interface{}      `json:"thumb,omitempty"`              // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	Caption           string           `json:"caption,omitempty"`            // Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
-	ParseMode         string           `json:"parse_mode,omitempty"`         // Optional. Mode for parsing entities in the video caption. See formatting options for more details.
-	CaptionEntities   []*MessageEntity `json:"caption_entities,omitempty"`   // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	Width             int              `json:"width,omitempty"`              // Optional. Video width
-	Height            int              `json:"height,omitempty"`             // Optional. Video height
-	Duration          int              `json:"duration,omitempty"`           // Optional. Video duration
-	SupportsStreaming bool             `json:"supports_streaming,omitempty"` // Optional. Pass True, if the uploaded video is suitable for streaming
+	Media                 string           `json:"media"`                                   // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
+	Thumbnail             interface{}      `json:"thumbnail,omitempty"`                     // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	Caption               string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
+	ParseMode             string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the video caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	Width                 int              `json:"width,omitempty"`                         // Optional. Video width
+	Height                int              `json:"height,omitempty"`                        // Optional. Video height
+	Duration              int              `json:"duration,omitempty"`                      // Optional. Video duration
+	SupportsStreaming     bool             `json:"supports_streaming,omitempty"`            // Optional. Pass True, if the uploaded video is suitable for streaming
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`                   // Optional. Pass True if the video needs to be covered with a spoiler animation
 }
 
 type InputMediaAnimation struct {
-	Type            string           `json:"type"`                       // Type of the result, must be animation
-	Media           string           `json:"media"`                      // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
-	Thumb           interface{}      `json:"thumb,omitempty"`            // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	Caption         string           `json:"caption,omitempty"`          // Optional. Caption of the animation to be sent, 0-1024 characters after entities parsing
-	ParseMode       string           `json:"parse_mode,omitempty"`       // Optional. Mode for parsing entities in the animation caption. See formatting options for more details.
-	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"` // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	Width           int              `json:"width,omitempty"`            // Optional. Animation width
-	Height          int              `json:"height,omitempty"`           // Optional. Animation height
-	Duration        int              `json:"duration,omitempty"`         // Optional. Animation duration
+	Media                 string           `json:"media"`                                   // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
+	Thumbnail             interface{}      `json:"thumbnail,omitempty"`                     // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	Caption               string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the animation to be sent, 0-1024 characters after entities parsing
+	ParseMode             string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the animation caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	Width                 int              `json:"width,omitempty"`                         // Optional. Animation width
+	Height                int              `json:"height,omitempty"`                        // Optional. Animation height
+	Duration              int              `json:"duration,omitempty"`                      // Optional. Animation duration
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`                   // Optional. Pass True if the animation needs to be covered with a spoiler animation
 }
 
 type InputMediaAudio struct {
-	Type            string           `json:"type"`                       // Type of the result, must be audio
-	Media           string           `json:"media"`                      // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
-	Thumb           interface{}      `json:"thumb,omitempty"`            // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	Caption         string           `json:"caption,omitempty"`          // Optional. Caption of the audio to be sent, 0-1024 characters after entities parsing
-	ParseMode       string           `json:"parse_mode,omitempty"`       // Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
-	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"` // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	Duration        int              `json:"duration,omitempty"`         // Optional. Duration of the audio in seconds
-	Performer       string           `json:"performer,omitempty"`        // Optional. Performer of the audio
-	Title           string           `json:"title,omitempty"`            // Optional. Title of the audio
+	Media           string           `json:"media"`                                   // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
+	Thumbnail       interface{}      `json:"thumbnail,omitempty"`                     // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	Caption         string           `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the audio to be sent, 0-1024 characters after entities parsing
+	ParseMode       string           `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	Duration        int              `json:"duration,omitempty"`                      // Optional. Duration of the audio in seconds
+	Performer       string           `json:"performer,omitempty"`                     // Optional. Performer of the audio
+	Title           string           `json:"title,omitempty"`                         // Optional. Title of the audio
 }
 
 type InputMediaDocument struct {
-	Type                        string           `json:"type"`                                     // Type of the result, must be document
 	Media                       string           `json:"media"`                                    // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More info on Sending Files »
-	Thumb                       interface{}      `json:"thumb,omitempty"`                          // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
-	Caption                     string           `json:"caption,omitempty"`                        // Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
+	Thumbnail                   interface{}      `json:"thumbnail,omitempty"`                      // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	Caption                     string           `json:"caption,omitempty" validate:"len=0-1024"`  // Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
 	ParseMode                   string           `json:"parse_mode,omitempty"`                     // Optional. Mode for parsing entities in the document caption. See formatting options for more details.
 	CaptionEntities             []*MessageEntity `json:"caption_entities,omitempty"`               // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
 	DisableContentTypeDetection bool             `json:"disable_content_type_detection,omitempty"` // Optional. Disables automatic server-side content type detection for files uploaded using multipart/form-data. Always true, if the document is sent as part of an album.
 }
 
+type InputPaidMediaPhoto struct {
+	Media string `json:"media"` // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More information on Sending Files »
+}
+
+type InputPaidMediaVideo struct {
+	Media             string      `json:"media"`                        // File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass "attach://<file_attach_name>" to upload a new one using multipart/form-data under <file_attach_name> name. More information on Sending Files »
+	Thumbnail         interface{} `json:"thumbnail,omitempty"`          // Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can pass "attach://<file_attach_name>" if the thumbnail was uploaded using multipart/form-data under <file_attach_name>. More info on Sending Files »
+	Width             int         `json:"width,omitempty"`              // Optional. Video width
+	Height            int         `json:"height,omitempty"`             // Optional. Video height
+	Duration          int         `json:"duration,omitempty"`           // Optional. Video duration in seconds
+	SupportsStreaming bool        `json:"supports_streaming,omitempty"` // Optional. Pass True if the uploaded video is suitable for streaming
+}
+
 type Sticker struct {
-	FileId       string        `json:"file_id"`                 // Identifier for this file, which can be used to download or reuse the file
-	FileUniqueId string        `json:"file_unique_id"`          // Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
-	Width        int           `json:"width"`                   // Sticker width
-	Height       int           `json:"height"`                  // Sticker height
-	IsAnimated   bool          `json:"is_animated"`             // True, if the sticker is animated
-	Thumb        *PhotoSize    `json:"thumb,omitempty"`         // Optional. Sticker thumbnail in the .WEBP or .JPG format
-	Emoji        string        `json:"emoji,omitempty"`         // Optional. Emoji associated with the sticker
-	SetName      string        `json:"set_name,omitempty"`      // Optional. Name of the sticker set to which the sticker belongs
-	MaskPosition *MaskPosition `json:"mask_position,omitempty"` // Optional. For mask stickers, the position where the mask should be placed
-	FileSize     int           `json:"file_size,omitempty"`     // Optional. File size
+	FileId           string        `json:"file_id"`                     // Identifier for this file, which can be used to download or reuse the file
+	FileUniqueId     string        `json:"file_unique_id"`              // Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	Type             string        `json:"type"`                        // Type of the sticker, currently one of "regular", "mask", "custom_emoji". The type of the sticker is independent from its format, which is determined by the fields is_animated and is_video.
+	Width            int           `json:"width"`                       // Sticker width
+	Height           int           `json:"height"`                      // Sticker height
+	IsAnimated       bool          `json:"is_animated"`                 // True, if the sticker is animated
+	IsVideo          bool          `json:"is_video"`                    // True, if the sticker is a video sticker
+	Thumbnail        *PhotoSize    `json:"thumbnail,omitempty"`         // Optional. Sticker thumbnail in the .WEBP or .JPG format
+	Emoji            string        `json:"emoji,omitempty"`             // Optional. Emoji associated with the sticker
+	SetName          string        `json:"set_name,omitempty"`          // Optional. Name of the sticker set to which the sticker belongs
+	PremiumAnimation *File         `json:"premium_animation,omitempty"` // Optional. For premium regular stickers, premium animation for the sticker
+	MaskPosition     *MaskPosition `json:"mask_position,omitempty"`     // Optional. For mask stickers, the position where the mask should be placed
+	CustomEmojiId    string        `json:"custom_emoji_id,omitempty"`   // Optional. For custom emoji stickers, unique identifier of the custom emoji
+	NeedsRepainting  bool          `json:"needs_repainting,omitempty"`  // Optional. True, if the sticker must be repainted to a text color in messages, the color of the Telegram Premium badge in emoji status, white color on chat photos, or another appropriate color in other places
+	FileSize         int           `json:"file_size,omitempty"`         // Optional. File size
 }
 
 type StickerSet struct {
-	Name          string     `json:"name"`            // Sticker set name
-	Title         string     `json:"title"`           // Sticker set title
-	IsAnimated    bool       `json:"is_animated"`     // True, if the sticker set contains animated stickers
-	ContainsMasks bool       `json:"contains_masks"`  // True, if the sticker set contains masks
-	Stickers      []*Sticker `json:"stickers"`        // List of all set stickers
-	Thumb         *PhotoSize `json:"thumb,omitempty"` // Optional. Sticker set thumbnail in the .WEBP or .TGS format
+	Name        string     `json:"name"`                // Sticker set name
+	Title       string     `json:"title"`               // Sticker set title
+	StickerType string     `json:"sticker_type"`        // Type of stickers in the set, currently one of "regular", "mask", "custom_emoji"
+	Stickers    []*Sticker `json:"stickers"`            // List of all set stickers
+	Thumbnail   *PhotoSize `json:"thumbnail,omitempty"` // Optional. Sticker set thumbnail in the .WEBP or .TGS format
 }
 
 type MaskPosition struct {
@@ -604,6 +1328,14 @@ type MaskPosition struct {
 	Scale  float64 `json:"scale"`   // Mask scaling coefficient. For example, 2.0 means double size.
 }
 
+type InputSticker struct {
+	Sticker      interface{}   `json:"sticker"`                                // The added sticker. Pass a file_id as a String to send a file that already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, upload a new file using multipart/form-data, or pass "attach://<file_attach_name>" to upload a new file using multipart/form-data under <file_attach_name> name. Animated and video stickers can't be uploaded via HTTP URL.
+	Format       string        `json:"format"`                                 // Format of the added sticker, must be one of "static" for a .WEBP or .PNG image, "animated" for a .TGS animation, "video" for a WEBM video
+	EmojiList    []string      `json:"emoji_list" validate:"len=1-20"`         // List of 1-20 emoji associated with the sticker
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`                // Optional. Position where the mask should be placed on faces. For "mask" stickers only.
+	Keywords     []string      `json:"keywords,omitempty" validate:"len=0-20"` // Optional. List of 0-20 search keywords for the sticker with total length of up to 64 characters. For "regular" and "custom_emoji" stickers only.
+}
+
 type InlineQuery struct {
 	Id       string    `json:"id"`                  // Unique identifier for this query
 	From     *User     `json:"from"`                // Sender
@@ -613,135 +1345,136 @@ type InlineQuery struct {
 	Location *Location `json:"location,omitempty"`  // Optional. Sender location, only for bots that request user location
 }
 
+type InlineQueryResultsButton struct {
+	Text           string      `json:"text"`                                          // Label text on the button
+	WebApp         *WebAppInfo `json:"web_app,omitempty"`                             // Optional. Description of the Web App that will be launched when the user presses the button. The Web App will be able to switch back to the inline mode using the method switchInlineQuery inside the Web App.
+	StartParameter string      `json:"start_parameter,omitempty" validate:"len=1-64"` // Optional. Deep-linking parameter for the /start message sent to the bot when a user presses the button. 1-64 characters, only A-Z, a-z, 0-9, _ and - are allowed.
+}
+
 type InlineQueryResultArticle struct {
-	Type                string                `json:"type"`                   // Type of the result, must be article
-	Id                  string                `json:"id"`                     // Unique identifier for this result, 1-64 Bytes
-	Title               string                `json:"title"`                  // Title of the result
-	InputMessageContent interface{}           `json:"input_message_content"`  // Content of the message to be sent
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"` // Optional. Inline keyboard attached to the message
-	Url                 string                `json:"url,omitempty"`          // Optional. URL of the result
-	HideUrl             bool                  `json:"hide_url,omitempty"`     // Optional. Pass True, if you don't want the URL to be shown in the message
-	Description         string                `json:"description,omitempty"`  // Optional. Short description of the result
-	ThumbUrl            string                `json:"thumb_url,omitempty"`    // Optional. Url of the thumbnail for the result
-	ThumbWidth          int                   `json:"thumb_width,omitempty"`  // Optional. Thumbnail width
-	ThumbHeight         int                   `json:"thumb_height,omitempty"` // Optional. Thumbnail height
+	Id                  string                `json:"id" validate:"bytes=1-64"`   // Unique identifier for this result, 1-64 Bytes
+	Title               string                `json:"title"`                      // Title of the result
+	InputMessageContent InputMessageContent   `json:"input_message_content"`      // Content of the message to be sent
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`     // Optional. Inline keyboard attached to the message
+	Url                 string                `json:"url,omitempty"`              // Optional. URL of the result
+	HideUrl             bool                  `json:"hide_url,omitempty"`         // Optional. Pass True, if you don't want the URL to be shown in the message
+	Description         string                `json:"description,omitempty"`      // Optional. Short description of the result
+	ThumbnailUrl        string                `json:"thumbnail_url,omitempty"`    // Optional. Url of the thumbnail for the result
+	ThumbnailWidth      int                   `json:"thumbnail_width,omitempty"`  // Optional. Thumbnail width
+	ThumbnailHeight     int                   `json:"thumbnail_height,omitempty"` // Optional. Thumbnail height
 }
 
 type InlineQueryResultPhoto struct {
-	Type                string                `json:"type"`                            // Type of the result, must be photo
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	PhotoUrl            string                `json:"photo_url"`                       // A valid URL of the photo. Photo must be in jpeg format. Photo size must not exceed 5MB
-	ThumbUrl            string                `json:"thumb_url"`                       // URL of the thumbnail for the photo
-	PhotoWidth          int                   `json:"photo_width,omitempty"`           // Optional. Width of the photo
-	PhotoHeight         int                   `json:"photo_height,omitempty"`          // Optional. Height of the photo
-	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
-	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the photo
+	Id                    string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	PhotoUrl              string                `json:"photo_url"`                               // A valid URL of the photo. Photo must be in jpeg format. Photo size must not exceed 5MB
+	ThumbnailUrl          string                `json:"thumbnail_url"`                           // URL of the thumbnail for the photo
+	PhotoWidth            int                   `json:"photo_width,omitempty"`                   // Optional. Width of the photo
+	PhotoHeight           int                   `json:"photo_height,omitempty"`                  // Optional. Height of the photo
+	Title                 string                `json:"title,omitempty"`                         // Optional. Title for the result
+	Description           string                `json:"description,omitempty"`                   // Optional. Short description of the result
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the photo
 }
 
 type InlineQueryResultGif struct {
-	Type                string                `json:"type"`                            // Type of the result, must be gif
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	GifUrl              string                `json:"gif_url"`                         // A valid URL for the GIF file. File size must not exceed 1MB
-	GifWidth            int                   `json:"gif_width,omitempty"`             // Optional. Width of the GIF
-	GifHeight           int                   `json:"gif_height,omitempty"`            // Optional. Height of the GIF
-	GifDuration         int                   `json:"gif_duration,omitempty"`          // Optional. Duration of the GIF
-	ThumbUrl            string                `json:"thumb_url"`                       // URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the result
-	ThumbMimeType       string                `json:"thumb_mime_type,omitempty"`       // Optional. MIME type of the thumbnail, must be one of "image/jpeg", "image/gif", or "video/mp4". Defaults to "image/jpeg"
-	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the GIF file to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the GIF animation
+	Id                    string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	GifUrl                string                `json:"gif_url"`                                 // A valid URL for the GIF file. File size must not exceed 1MB
+	GifWidth              int                   `json:"gif_width,omitempty"`                     // Optional. Width of the GIF
+	GifHeight             int                   `json:"gif_height,omitempty"`                    // Optional. Height of the GIF
+	GifDuration           int                   `json:"gif_duration,omitempty"`                  // Optional. Duration of the GIF
+	ThumbnailUrl          string                `json:"thumbnail_url"`                           // URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the result
+	ThumbnailMimeType     string                `json:"thumbnail_mime_type,omitempty"`           // Optional. MIME type of the thumbnail, must be one of "image/jpeg", "image/gif", or "video/mp4". Defaults to "image/jpeg"
+	Title                 string                `json:"title,omitempty"`                         // Optional. Title for the result
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the GIF file to be sent, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the GIF animation
 }
 
 type InlineQueryResultMpeg4Gif struct {
-	Type                string                `json:"type"`                            // Type of the result, must be mpeg4_gif
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	Mpeg4Url            string                `json:"mpeg4_url"`                       // A valid URL for the MP4 file. File size must not exceed 1MB
-	Mpeg4Width          int                   `json:"mpeg4_width,omitempty"`           // Optional. Video width
-	Mpeg4Height         int                   `json:"mpeg4_height,omitempty"`          // Optional. Video height
-	Mpeg4Duration       int                   `json:"mpeg4_duration,omitempty"`        // Optional. Video duration
-	ThumbUrl            string                `json:"thumb_url"`                       // URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the result
-	ThumbMimeType       string                `json:"thumb_mime_type,omitempty"`       // Optional. MIME type of the thumbnail, must be one of "image/jpeg", "image/gif", or "video/mp4". Defaults to "image/jpeg"
-	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the MPEG-4 file to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the video animation
+	Id                    string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	Mpeg4Url              string                `json:"mpeg4_url"`                               // A valid URL for the MP4 file. File size must not exceed 1MB
+	Mpeg4Width            int                   `json:"mpeg4_width,omitempty"`                   // Optional. Video width
+	Mpeg4Height           int                   `json:"mpeg4_height,omitempty"`                  // Optional. Video height
+	Mpeg4Duration         int                   `json:"mpeg4_duration,omitempty"`                // Optional. Video duration
+	ThumbnailUrl          string                `json:"thumbnail_url"`                           // URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the result
+	ThumbnailMimeType     string                `json:"thumbnail_mime_type,omitempty"`           // Optional. MIME type of the thumbnail, must be one of "image/jpeg", "image/gif", or "video/mp4". Defaults to "image/jpeg"
+	Title                 string                `json:"title,omitempty"`                         // Optional. Title for the result
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the MPEG-4 file to be sent, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the video animation
 }
 
 type InlineQueryResultVideo struct {
-	Type                string                `json:"type"`                            // Type of the result, must be video
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	VideoUrl            string                `json:"video_url"`                       // A valid URL for the embedded video player or video file
-	MimeType            string                `json:"mime_type"`                       // Mime type of the content of video url, "text/html" or "video/mp4"
-	ThumbUrl            string                `json:"thumb_url"`                       // URL of the thumbnail (jpeg only) for the video
-	Title               string                `json:"title"`                           // Title for the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the video caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	VideoWidth          int                   `json:"video_width,omitempty"`           // Optional. Video width
-	VideoHeight         int                   `json:"video_height,omitempty"`          // Optional. Video height
-	VideoDuration       int                   `json:"video_duration,omitempty"`        // Optional. Video duration in seconds
-	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the video. This field is required if InlineQueryResultVideo is used to send an HTML-page as a result (e.g., a YouTube video).
+	Id                    string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	VideoUrl              string                `json:"video_url"`                               // A valid URL for the embedded video player or video file
+	MimeType              string                `json:"mime_type"`                               // Mime type of the content of video url, "text/html" or "video/mp4"
+	ThumbnailUrl          string                `json:"thumbnail_url"`                           // URL of the thumbnail (jpeg only) for the video
+	Title                 string                `json:"title"`                                   // Title for the result
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the video caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	VideoWidth            int                   `json:"video_width,omitempty"`                   // Optional. Video width
+	VideoHeight           int                   `json:"video_height,omitempty"`                  // Optional. Video height
+	VideoDuration         int                   `json:"video_duration,omitempty"`                // Optional. Video duration in seconds
+	Description           string                `json:"description,omitempty"`                   // Optional. Short description of the result
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the video. This field is required if InlineQueryResultVideo is used to send an HTML-page as a result (e.g., a YouTube video).
 }
 
 type InlineQueryResultAudio struct {
-	Type                string                `json:"type"`                            // Type of the result, must be audio
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	AudioUrl            string                `json:"audio_url"`                       // A valid URL for the audio file
-	Title               string                `json:"title"`                           // Title
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	Performer           string                `json:"performer,omitempty"`             // Optional. Performer
-	AudioDuration       int                   `json:"audio_duration,omitempty"`        // Optional. Audio duration in seconds
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the audio
+	Id                  string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	AudioUrl            string                `json:"audio_url"`                               // A valid URL for the audio file
+	Title               string                `json:"title"`                                   // Title
+	Caption             string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption, 0-1024 characters after entities parsing
+	ParseMode           string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	Performer           string                `json:"performer,omitempty"`                     // Optional. Performer
+	AudioDuration       int                   `json:"audio_duration,omitempty"`                // Optional. Audio duration in seconds
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the audio
 }
 
 type InlineQueryResultVoice struct {
-	Type                string                `json:"type"`                            // Type of the result, must be voice
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	VoiceUrl            string                `json:"voice_url"`                       // A valid URL for the voice recording
-	Title               string                `json:"title"`                           // Recording title
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the voice message caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	VoiceDuration       int                   `json:"voice_duration,omitempty"`        // Optional. Recording duration in seconds
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the voice recording
+	Id                  string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	VoiceUrl            string                `json:"voice_url"`                               // A valid URL for the voice recording
+	Title               string                `json:"title"`                                   // Recording title
+	Caption             string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption, 0-1024 characters after entities parsing
+	ParseMode           string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the voice message caption. See formatting options for more details.
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	VoiceDuration       int                   `json:"voice_duration,omitempty"`                // Optional. Recording duration in seconds
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the voice recording
 }
 
 type InlineQueryResultDocument struct {
-	Type                string                `json:"type"`                            // Type of the result, must be document
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	Title               string                `json:"title"`                           // Title for the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the document caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	DocumentUrl         string                `json:"document_url"`                    // A valid URL for the file
-	MimeType            string                `json:"mime_type"`                       // Mime type of the content of the file, either "application/pdf" or "application/zip"
-	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the file
-	ThumbUrl            string                `json:"thumb_url,omitempty"`             // Optional. URL of the thumbnail (jpeg only) for the file
-	ThumbWidth          int                   `json:"thumb_width,omitempty"`           // Optional. Thumbnail width
-	ThumbHeight         int                   `json:"thumb_height,omitempty"`          // Optional. Thumbnail height
+	Id                  string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	Title               string                `json:"title"`                                   // Title for the result
+	Caption             string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
+	ParseMode           string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the document caption. See formatting options for more details.
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	DocumentUrl         string                `json:"document_url"`                            // A valid URL for the file
+	MimeType            string                `json:"mime_type"`                               // Mime type of the content of the file, either "application/pdf" or "application/zip"
+	Description         string                `json:"description,omitempty"`                   // Optional. Short description of the result
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the file
+	ThumbnailUrl        string                `json:"thumbnail_url,omitempty"`                 // Optional. URL of the thumbnail (jpeg only) for the file
+	ThumbnailWidth      int                   `json:"thumbnail_width,omitempty"`               // Optional. Thumbnail width
+	ThumbnailHeight     int                   `json:"thumbnail_height,omitempty"`              // Optional. Thumbnail height
 }
 
 type InlineQueryResultLocation struct {
-	Type                 string                `json:"type"`                             // Type of the result, must be location
-	Id                   string                `json:"id"`                               // Unique identifier for this result, 1-64 Bytes
+	Id                   string                `json:"id" validate:"bytes=1-64"`         // Unique identifier for this result, 1-64 Bytes
 	Latitude             float64               `json:"latitude"`                         // Location latitude in degrees
 	Longitude            float64               `json:"longitude"`                        // Location longitude in degrees
 	Title                string                `json:"title"`                            // Location title
@@ -750,15 +1483,14 @@ type InlineQueryResultLocation struct {
 	Heading              int                   `json:"heading,omitempty"`                // Optional. For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
 	ProximityAlertRadius int                   `json:"proximity_alert_radius,omitempty"` // Optional. For live locations, a maximum distance for proximity alerts about approaching another chat member, in meters. Must be between 1 and 100000 if specified.
 	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`           // Optional. Inline keyboard attached to the message
-	InputMessageContent  interface{}           `json:"input_message_content,omitempty"`  // Optional. Content of the message to be sent instead of the location
-	ThumbUrl             string                `json:"thumb_url,omitempty"`              // Optional. Url of the thumbnail for the result
-	ThumbWidth           int                   `json:"thumb_width,omitempty"`            // Optional. Thumbnail width
-	ThumbHeight          int                   `json:"thumb_height,omitempty"`           // Optional. Thumbnail height
+	InputMessageContent  InputMessageContent   `json:"input_message_content,omitempty"`  // Optional. Content of the message to be sent instead of the location
+	ThumbnailUrl         string                `json:"thumbnail_url,omitempty"`          // Optional. Url of the thumbnail for the result
+	ThumbnailWidth       int                   `json:"thumbnail_width,omitempty"`        // Optional. Thumbnail width
+	ThumbnailHeight      int                   `json:"thumbnail_height,omitempty"`       // Optional. Thumbnail height
 }
 
 type InlineQueryResultVenue struct {
-	Type                string                `json:"type"`                            // Type of the result, must be venue
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 Bytes
+	Id                  string                `json:"id" validate:"bytes=1-64"`        // Unique identifier for this result, 1-64 Bytes
 	Latitude            float64               `json:"latitude"`                        // Latitude of the venue location in degrees
 	Longitude           float64               `json:"longitude"`                       // Longitude of the venue location in degrees
 	Title               string                `json:"title"`                           // Title of the venue
@@ -768,132 +1500,127 @@ type InlineQueryResultVenue struct {
 	GooglePlaceId       string                `json:"google_place_id,omitempty"`       // Optional. Google Places identifier of the venue
 	GooglePlaceType     string                `json:"google_place_type,omitempty"`     // Optional. Google Places type of the venue. (See supported types.)
 	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the venue
-	ThumbUrl            string                `json:"thumb_url,omitempty"`             // Optional. Url of the thumbnail for the result
-	ThumbWidth          int                   `json:"thumb_width,omitempty"`           // Optional. Thumbnail width
-	ThumbHeight         int                   `json:"thumb_height,omitempty"`          // Optional. Thumbnail height
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the venue
+	ThumbnailUrl        string                `json:"thumbnail_url,omitempty"`         // Optional. Url of the thumbnail for the result
+	ThumbnailWidth      int                   `json:"thumbnail_width,omitempty"`       // Optional. Thumbnail width
+	ThumbnailHeight     int                   `json:"thumbnail_height,omitempty"`      // Optional. Thumbnail height
 }
 
 type InlineQueryResultContact struct {
-	Type                string                `json:"type"`                            // Type of the result, must be contact
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 Bytes
+	Id                  string                `json:"id" validate:"bytes=1-64"`        // Unique identifier for this result, 1-64 Bytes
 	PhoneNumber         string                `json:"phone_number"`                    // Contact's phone number
 	FirstName           string                `json:"first_name"`                      // Contact's first name
 	LastName            string                `json:"last_name,omitempty"`             // Optional. Contact's last name
 	Vcard               string                `json:"vcard,omitempty"`                 // Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
 	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the contact
-	ThumbUrl            string                `json:"thumb_url,omitempty"`             // Optional. Url of the thumbnail for the result
-	ThumbWidth          int                   `json:"thumb_width,omitempty"`           // Optional. Thumbnail width
-	ThumbHeight         int                   `json:"thumb_height,omitempty"`          // Optional. Thumbnail height
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the contact
+	ThumbnailUrl        string                `json:"thumbnail_url,omitempty"`         // Optional. Url of the thumbnail for the result
+	ThumbnailWidth      int                   `json:"thumbnail_width,omitempty"`       // Optional. Thumbnail width
+	ThumbnailHeight     int                   `json:"thumbnail_height,omitempty"`      // Optional. Thumbnail height
 }
 
 type InlineQueryResultGame struct {
-	Type          string                `json:"type"`                   // Type of the result, must be game
-	Id            string                `json:"id"`                     // Unique identifier for this result, 1-64 bytes
-	GameShortName string                `json:"game_short_name"`        // Short name of the game
-	ReplyMarkup   *InlineKeyboardMarkup `json:"reply_markup,omitempty"` // Optional. Inline keyboard attached to the message
+	Id            string                `json:"id" validate:"bytes=1-64"` // Unique identifier for this result, 1-64 bytes
+	GameShortName string                `json:"game_short_name"`          // Short name of the game
+	ReplyMarkup   *InlineKeyboardMarkup `json:"reply_markup,omitempty"`   // Optional. Inline keyboard attached to the message
 }
 
 type InlineQueryResultCachedPhoto struct {
-	Type                string                `json:"type"`                            // Type of the result, must be photo
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	PhotoFileId         string                `json:"photo_file_id"`                   // A valid file identifier of the photo
-	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
-	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the photo
+	Id                    string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	PhotoFileId           string                `json:"photo_file_id"`                           // A valid file identifier of the photo
+	Title                 string                `json:"title,omitempty"`                         // Optional. Title for the result
+	Description           string                `json:"description,omitempty"`                   // Optional. Short description of the result
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the photo
 }
 
 type InlineQueryResultCachedGif struct {
-	Type                string                `json:"type"`                            // Type of the result, must be gif
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	GifFileId           string                `json:"gif_file_id"`                     // A valid file identifier for the GIF file
-	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the GIF file to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the GIF animation
+	Id                    string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	GifFileId             string                `json:"gif_file_id"`                             // A valid file identifier for the GIF file
+	Title                 string                `json:"title,omitempty"`                         // Optional. Title for the result
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the GIF file to be sent, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the GIF animation
 }
 
 type InlineQueryResultCachedMpeg4Gif struct {
-	Type                string                `json:"type"`                            // Type of the result, must be mpeg4_gif
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	Mpeg4FileId         string                `json:"mpeg4_file_id"`                   // A valid file identifier for the MP4 file
-	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the MPEG-4 file to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the video animation
+	Id                    string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	Mpeg4FileId           string                `json:"mpeg4_file_id"`                           // A valid file identifier for the MP4 file
+	Title                 string                `json:"title,omitempty"`                         // Optional. Title for the result
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the MPEG-4 file to be sent, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the video animation
 }
 
 type InlineQueryResultCachedSticker struct {
-	Type                string                `json:"type"`                            // Type of the result, must be sticker
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
+	Id                  string                `json:"id" validate:"bytes=1-64"`        // Unique identifier for this result, 1-64 bytes
 	StickerFileId       string                `json:"sticker_file_id"`                 // A valid file identifier of the sticker
 	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the sticker
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the sticker
 }
 
 type InlineQueryResultCachedDocument struct {
-	Type                string                `json:"type"`                            // Type of the result, must be document
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	Title               string                `json:"title"`                           // Title for the result
-	DocumentFileId      string                `json:"document_file_id"`                // A valid file identifier for the file
-	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the document caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the file
+	Id                  string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	Title               string                `json:"title"`                                   // Title for the result
+	DocumentFileId      string                `json:"document_file_id"`                        // A valid file identifier for the file
+	Description         string                `json:"description,omitempty"`                   // Optional. Short description of the result
+	Caption             string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
+	ParseMode           string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the document caption. See formatting options for more details.
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the file
 }
 
 type InlineQueryResultCachedVideo struct {
-	Type                string                `json:"type"`                            // Type of the result, must be video
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	VideoFileId         string                `json:"video_file_id"`                   // A valid file identifier for the video file
-	Title               string                `json:"title"`                           // Title for the result
-	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the video caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the video
+	Id                    string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	VideoFileId           string                `json:"video_file_id"`                           // A valid file identifier for the video file
+	Title                 string                `json:"title"`                                   // Title for the result
+	Description           string                `json:"description,omitempty"`                   // Optional. Short description of the result
+	Caption               string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
+	ParseMode             string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the video caption. See formatting options for more details.
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`      // Optional. Pass True, if the caption must be shown above the message media
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the video
 }
 
 type InlineQueryResultCachedVoice struct {
-	Type                string                `json:"type"`                            // Type of the result, must be voice
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	VoiceFileId         string                `json:"voice_file_id"`                   // A valid file identifier for the voice message
-	Title               string                `json:"title"`                           // Voice message title
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the voice message caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the voice message
+	Id                  string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	VoiceFileId         string                `json:"voice_file_id"`                           // A valid file identifier for the voice message
+	Title               string                `json:"title"`                                   // Voice message title
+	Caption             string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption, 0-1024 characters after entities parsing
+	ParseMode           string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the voice message caption. See formatting options for more details.
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the voice message
 }
 
 type InlineQueryResultCachedAudio struct {
-	Type                string                `json:"type"`                            // Type of the result, must be audio
-	Id                  string                `json:"id"`                              // Unique identifier for this result, 1-64 bytes
-	AudioFileId         string                `json:"audio_file_id"`                   // A valid file identifier for the audio file
-	Caption             string                `json:"caption,omitempty"`               // Optional. Caption, 0-1024 characters after entities parsing
-	ParseMode           string                `json:"parse_mode,omitempty"`            // Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
-	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`      // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
-	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
-	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the audio
+	Id                  string                `json:"id" validate:"bytes=1-64"`                // Unique identifier for this result, 1-64 bytes
+	AudioFileId         string                `json:"audio_file_id"`                           // A valid file identifier for the audio file
+	Caption             string                `json:"caption,omitempty" validate:"len=0-1024"` // Optional. Caption, 0-1024 characters after entities parsing
+	ParseMode           string                `json:"parse_mode,omitempty"`                    // Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`              // Optional. List of special entities that appear in the caption, which can be specified instead of parse_mode
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`                  // Optional. Inline keyboard attached to the message
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`         // Optional. Content of the message to be sent instead of the audio
 }
 
 type InputTextMessageContent struct {
-	MessageText           string           `json:"message_text"`                       // Text of the message to be sent, 1-4096 characters
-	ParseMode             string           `json:"parse_mode,omitempty"`               // Optional. Mode for parsing entities in the message text. See formatting options for more details.
-	Entities              []*MessageEntity `json:"entities,omitempty"`                 // Optional. List of special entities that appear in message text, which can be specified instead of parse_mode
-	DisableWebPagePreview bool             `json:"disable_web_page_preview,omitempty"` // Optional. Disables link previews for links in the sent message
+	MessageText           string              `json:"message_text" validate:"len=1-4096"` // Text of the message to be sent, 1-4096 characters
+	ParseMode             string              `json:"parse_mode,omitempty"`               // Optional. Mode for parsing entities in the message text. See formatting options for more details.
+	Entities              []*MessageEntity    `json:"entities,omitempty"`                 // Optional. List of special entities that appear in message text, which can be specified instead of parse_mode
+	LinkPreviewOptions    *LinkPreviewOptions `json:"link_preview_options,omitempty"`     // Optional. Link preview generation options for the message
+	DisableWebPagePreview bool                `json:"disable_web_page_preview,omitempty"` // Optional. Deprecated, use LinkPreviewOptions. Disables link previews for links in the sent message
 }
 
 type InputLocationMessageContent struct {
@@ -924,11 +1651,11 @@ type InputContactMessageContent struct {
 }
 
 type InputInvoiceMessageContent struct {
-	Title                     string          `json:"title"`                                   // Product name, 1-32 characters
-	Description               string          `json:"description"`                             // Product description, 1-255 characters
-	Payload                   string          `json:"payload"`                                 // Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your internal processes.
-	ProviderToken             string          `json:"provider_token"`                          // Payment provider token, obtained via Botfather
-	Currency                  string          `json:"currency"`                                // Three-letter ISO 4217 currency code, see more on currencies
+	Title                     string          `json:"title" validate:"len=1-32"`               // Product name, 1-32 characters
+	Description               string          `json:"description" validate:"len=1-255"`        // Product description, 1-255 characters
+	Payload                   string          `json:"payload" validate:"bytes=1-128"`          // Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your internal processes.
+	ProviderToken             string          `json:"provider_token,omitempty"`                // Optional. Payment provider token, obtained via @BotFather. Pass an empty string for payments in Telegram Stars.
+	Currency                  string          `json:"currency"`                                // Three-letter ISO 4217 currency code, see more on currencies. Pass "XTR" for payments in Telegram Stars.
 	Prices                    []*LabeledPrice `json:"prices"`                                  // Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery cost, delivery tax, bonus, etc.)
 	MaxTipAmount              int             `json:"max_tip_amount,omitempty"`                // Optional. The maximum accepted amount for tips in the smallest units of the currency (integer, not float/double). For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145. See the exp parameter in currencies.json, it shows the number of digits past the decimal point for each currency (2 for the majority of currencies). Defaults to 0
 	SuggestedTipAmounts       []int           `json:"suggested_tip_amounts,omitempty"`         // Optional. A JSON-serialized array of suggested amounts of tip in the smallest units of the currency (integer, not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must be positive, passed in a strictly increased order and must not exceed max_tip_amount.
@@ -954,6 +1681,15 @@ type ChosenInlineResult struct {
 	Query           string    `json:"query"`                       // The query that was used to obtain the result
 }
 
+type SentWebAppMessage struct {
+	InlineMessageId string `json:"inline_message_id,omitempty"` // Optional. Identifier of the sent inline message. Available only if there is an inline keyboard attached to the message.
+}
+
+type WebAppData struct {
+	Data       string `json:"data"`        // The data. Be aware that a bad client can send arbitrary data in this field.
+	ButtonText string `json:"button_text"` // Text of the web_app keyboard button from which the Web App was opened. Be aware that a bad client can send arbitrary data in this field.
+}
+
 type LabeledPrice struct {
 	Label  string `json:"label"`  // Portion label
 	Amount int    `json:"amount"` // Price of the product in the smallest units of the currency (integer, not float/double). For example, for a price of US$ 1.45 pass amount = 145. See the exp parameter in currencies.json, it shows the number of digits past the decimal point for each currency (2 for the majority of currencies).
@@ -999,6 +1735,14 @@ type SuccessfulPayment struct {
 	ProviderPaymentChargeId string     `json:"provider_payment_charge_id"`   // Provider payment identifier
 }
 
+type RefundedPayment struct {
+	Currency                string `json:"currency"`                             // Three-letter ISO 4217 currency code, or "XTR" for payments in Telegram Stars. Currently, always "XTR"
+	TotalAmount             int    `json:"total_amount"`                         // Total refunded price in the smallest units of the currency (integer, not float/double). For example, for a price of US$ 1.45, total_amount = 145.
+	InvoicePayload          string `json:"invoice_payload"`                      // Bot-specified invoice payload
+	TelegramPaymentChargeId string `json:"telegram_payment_charge_id"`           // Telegram payment identifier
+	ProviderPaymentChargeId string `json:"provider_payment_charge_id,omitempty"` // Optional. Provider payment identifier
+}
+
 type ShippingQuery struct {
 	Id              string           `json:"id"`               // Unique query identifier
 	From            *User            `json:"from"`             // User who sent the query
@@ -1016,6 +1760,101 @@ type PreCheckoutQuery struct {
 	OrderInfo        *OrderInfo `json:"order_info,omitempty"`         // Optional. Order info provided by the user
 }
 
+type PaidMediaPurchased struct {
+	From             *User  `json:"from"`               // User who purchased the media
+	PaidMediaPayload string `json:"paid_media_payload"` // Bot-specified paid media payload
+}
+
+type RevenueWithdrawalStatePending struct {
+}
+
+type RevenueWithdrawalStateSucceeded struct {
+	Date int    `json:"date"` // Date the withdrawal was completed in Unix time
+	Url  string `json:"url"`  // An HTTPS URL that can be used to see transaction details
+}
+
+type RevenueWithdrawalStateFailed struct {
+}
+
+type TransactionPartnerUser struct {
+	User             *User       `json:"user"`                         // Information about the user
+	InvoicePayload   string      `json:"invoice_payload,omitempty"`    // Optional. Bot-specified invoice payload
+	PaidMedia        []PaidMedia `json:"paid_media,omitempty"`         // Optional. Information about the paid media bought by the user
+	PaidMediaPayload string      `json:"paid_media_payload,omitempty"` // Optional. Bot-specified paid media payload
+}
+
+func (transactionPartnerUser *TransactionPartnerUser) UnmarshalJSON(data []byte) error {
+	type plain TransactionPartnerUser
+	decoded := struct {
+		*plain
+		PaidMedia json.RawMessage `json:"paid_media"`
+	}{plain: (*plain)(transactionPartnerUser)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	transactionPartnerUser.PaidMedia, err = unmarshalPaidMedias(decoded.PaidMedia)
+	return err
+}
+
+type TransactionPartnerFragment struct {
+	WithdrawalState RevenueWithdrawalState `json:"withdrawal_state,omitempty"` // Optional. State of the transaction if the transaction is outgoing
+}
+
+func (transactionPartnerFragment *TransactionPartnerFragment) UnmarshalJSON(data []byte) error {
+	type plain TransactionPartnerFragment
+	decoded := struct {
+		*plain
+		WithdrawalState json.RawMessage `json:"withdrawal_state"`
+	}{plain: (*plain)(transactionPartnerFragment)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+	transactionPartnerFragment.WithdrawalState, err = unmarshalRevenueWithdrawalState(decoded.WithdrawalState)
+	return err
+}
+
+type TransactionPartnerTelegramAds struct {
+}
+
+type TransactionPartnerOther struct {
+}
+
+type StarTransaction struct {
+	Id       string             `json:"id"`                 // Unique identifier of the transaction. Coincides with the identifier of the original transaction for refund transactions. Coincides with SuccessfulPayment.telegram_payment_charge_id for successful incoming payments from users.
+	Amount   int                `json:"amount"`             // Number of Telegram Stars transferred by the transaction
+	Date     int                `json:"date"`               // Date the transaction was created in Unix time
+	Source   TransactionPartner `json:"source,omitempty"`   // Optional. Source of an incoming transaction (e.g., a user purchasing goods or services, Fragment refunding a failed withdrawal). Only for incoming transactions
+	Receiver TransactionPartner `json:"receiver,omitempty"` // Optional. Receiver of an outgoing transaction (e.g., a user for a purchase refund, Fragment for a withdrawal). Only for outgoing transactions
+}
+
+func (starTransaction *StarTransaction) UnmarshalJSON(data []byte) error {
+	type plain StarTransaction
+	decoded := struct {
+		*plain
+		Source   json.RawMessage `json:"source"`
+		Receiver json.RawMessage `json:"receiver"`
+	}{plain: (*plain)(starTransaction)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var err error
+
+	if starTransaction.Source, err = unmarshalTransactionPartner(decoded.Source); err != nil {
+		return err
+	}
+	starTransaction.Receiver, err = unmarshalTransactionPartner(decoded.Receiver)
+	return err
+}
+
+type StarTransactions struct {
+	Transactions []*StarTransaction `json:"transactions"` // The list of transactions
+}
+
 type PassportData struct {
 	Data        []*EncryptedPassportElement `json:"data"`        // Array with information about documents and other Telegram Passport elements that was shared with the bot
 	Credentials *EncryptedCredentials       `json:"credentials"` // Encrypted credentials required to decrypt the data
@@ -1048,7 +1887,6 @@ type EncryptedCredentials struct {
 }
 
 type PassportElementErrorDataField struct {
-	Source    string `json:"source"`     // Error source, must be data
 	Type      string `json:"type"`       // The section of the user's Telegram Passport which has the error, one of "personal_details", "passport", "driver_license", "identity_card", "internal_passport", "address"
 	FieldName string `json:"field_name"` // Name of the data field which has the error
 	DataHash  string `json:"data_hash"`  // Base64-encoded data hash
@@ -1056,56 +1894,48 @@ type PassportElementErrorDataField struct {
 }
 
 type PassportElementErrorFrontSide struct {
-	Source   string `json:"source"`    // Error source, must be front_side
 	Type     string `json:"type"`      // The section of the user's Telegram Passport which has the issue, one of "passport", "driver_license", "identity_card", "internal_passport"
 	FileHash string `json:"file_hash"` // Base64-encoded hash of the file with the front side of the document
 	Message  string `json:"message"`   // Error message
 }
 
 type PassportElementErrorReverseSide struct {
-	Source   string `json:"source"`    // Error source, must be reverse_side
 	Type     string `json:"type"`      // The section of the user's Telegram Passport which has the issue, one of "driver_license", "identity_card"
 	FileHash string `json:"file_hash"` // Base64-encoded hash of the file with the reverse side of the document
 	Message  string `json:"message"`   // Error message
 }
 
 type PassportElementErrorSelfie struct {
-	Source   string `json:"source"`    // Error source, must be selfie
 	Type     string `json:"type"`      // The section of the user's Telegram Passport which has the issue, one of "passport", "driver_license", "identity_card", "internal_passport"
 	FileHash string `json:"file_hash"` // Base64-encoded hash of the file with the selfie
 	Message  string `json:"message"`   // Error message
 }
 
 type PassportElementErrorFile struct {
-	Source   string `json:"source"`    // Error source, must be file
 	Type     string `json:"type"`      // The section of the user's Telegram Passport which has the issue, one of "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration"
 	FileHash string `json:"file_hash"` // Base64-encoded file hash
 	Message  string `json:"message"`   // Error message
 }
 
 type PassportElementErrorFiles struct {
-	Source     string   `json:"source"`      // Error source, must be files
 	Type       string   `json:"type"`        // The section of the user's Telegram Passport which has the issue, one of "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration"
 	FileHashes []string `json:"file_hashes"` // List of base64-encoded file hashes
 	Message    string   `json:"message"`     // Error message
 }
 
 type PassportElementErrorTranslationFile struct {
-	Source   string `json:"source"`    // Error source, must be translation_file
 	Type     string `json:"type"`      // Type of element of the user's Telegram Passport which has the issue, one of "passport", "driver_license", "identity_card", "internal_passport", "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration"
 	FileHash string `json:"file_hash"` // Base64-encoded file hash
 	Message  string `json:"message"`   // Error message
 }
 
 type PassportElementErrorTranslationFiles struct {
-	Source     string   `json:"source"`      // Error source, must be translation_files
 	Type       string   `json:"type"`        // Type of element of the user's Telegram Passport which has the issue, one of "passport", "driver_license", "identity_card", "internal_passport", "utility_bill", "bank_statement", "rental_agreement", "passport_registration", "temporary_registration"
 	FileHashes []string `json:"file_hashes"` // List of base64-encoded file hashes
 	Message    string   `json:"message"`     // Error message
 }
 
 type PassportElementErrorUnspecified struct {
-	Source      string `json:"source"`       // Error source, must be unspecified
 	Type        string `json:"type"`         // Type of element of the user's Telegram Passport which has the issue
 	ElementHash string `json:"element_hash"` // Base64-encoded element hash
 	Message     string `json:"message"`      // Error message
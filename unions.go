@@ -0,0 +1,928 @@
+// Code generated by gobot-gen from Bot API 7.10. DO NOT EDIT.
+
+package gobot
+
+import "encoding/json"
+
+// ReplyMarkup is implemented by InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove and ForceReply.
+type ReplyMarkup interface {
+	replyMarkup()
+}
+
+// InlineQueryResult is implemented by the InlineQueryResult* types, which set their own type when marshalled.
+type InlineQueryResult interface {
+	inlineQueryResult()
+}
+
+// BotCommandScope is implemented by the BotCommandScope* types, which set their own type when marshalled.
+type BotCommandScope interface {
+	botCommandScope()
+}
+
+// InputMessageContent is implemented by the Input*MessageContent types.
+type InputMessageContent interface {
+	inputMessageContent()
+}
+
+// InputMedia is implemented by the InputMedia* types, which set their own type when marshalled.
+type InputMedia interface {
+	inputMedia()
+}
+
+// PassportElementError is implemented by the PassportElementError* types, which set their own source when marshalled.
+type PassportElementError interface {
+	passportElementError()
+}
+
+// MessageOrigin is implemented by the MessageOrigin* types, which set their own type when marshalled.
+type MessageOrigin interface {
+	messageOrigin()
+}
+
+// PaidMedia is implemented by the PaidMedia* types, which set their own type when marshalled.
+type PaidMedia interface {
+	paidMedia()
+}
+
+// InputPaidMedia is implemented by the InputPaidMedia* types, which set their own type when marshalled.
+type InputPaidMedia interface {
+	inputPaidMedia()
+}
+
+// BackgroundFill is implemented by the BackgroundFill* types, which set their own type when marshalled.
+type BackgroundFill interface {
+	backgroundFill()
+}
+
+// BackgroundType is implemented by the BackgroundType* types, which set their own type when marshalled.
+type BackgroundType interface {
+	backgroundType()
+}
+
+// ReactionType is implemented by the ReactionType* types, which set their own type when marshalled.
+type ReactionType interface {
+	reactionType()
+}
+
+// MenuButton is implemented by the MenuButton* types, which set their own type when marshalled.
+type MenuButton interface {
+	menuButton()
+}
+
+// ChatBoostSource is implemented by the ChatBoostSource* types, which set their own source when marshalled.
+type ChatBoostSource interface {
+	chatBoostSource()
+}
+
+// RevenueWithdrawalState is implemented by the RevenueWithdrawalState* types, which set their own type when marshalled.
+type RevenueWithdrawalState interface {
+	revenueWithdrawalState()
+}
+
+// TransactionPartner is implemented by the TransactionPartner* types, which set their own type when marshalled.
+type TransactionPartner interface {
+	transactionPartner()
+}
+
+// marshalWithDiscriminator marshals value and adds the discriminator field in front of the other fields.
+func marshalWithDiscriminator(field string, discriminator string, value interface{}) ([]byte, error) {
+	encodedValue, err := json.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+	encoded := []byte(`{"` + field + `":"` + discriminator + `"`)
+
+	if len(encodedValue) > 2 {
+		encoded = append(encoded, ',')
+	}
+	return append(encoded, encodedValue[1:]...), nil
+}
+
+func (*InlineKeyboardMarkup) replyMarkup() {}
+
+func (*ReplyKeyboardMarkup) replyMarkup() {}
+
+func (*ReplyKeyboardRemove) replyMarkup() {}
+
+func (*ForceReply) replyMarkup() {}
+
+func (*InlineQueryResultArticle) inlineQueryResult() {}
+
+func (result *InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultArticle
+	return marshalWithDiscriminator("type", "article", (*plain)(result))
+}
+
+func (*InlineQueryResultPhoto) inlineQueryResult() {}
+
+func (result *InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultPhoto
+	return marshalWithDiscriminator("type", "photo", (*plain)(result))
+}
+
+func (*InlineQueryResultGif) inlineQueryResult() {}
+
+func (result *InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultGif
+	return marshalWithDiscriminator("type", "gif", (*plain)(result))
+}
+
+func (*InlineQueryResultMpeg4Gif) inlineQueryResult() {}
+
+func (result *InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultMpeg4Gif
+	return marshalWithDiscriminator("type", "mpeg4_gif", (*plain)(result))
+}
+
+func (*InlineQueryResultVideo) inlineQueryResult() {}
+
+func (result *InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultVideo
+	return marshalWithDiscriminator("type", "video", (*plain)(result))
+}
+
+func (*InlineQueryResultAudio) inlineQueryResult() {}
+
+func (result *InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultAudio
+	return marshalWithDiscriminator("type", "audio", (*plain)(result))
+}
+
+func (*InlineQueryResultVoice) inlineQueryResult() {}
+
+func (result *InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultVoice
+	return marshalWithDiscriminator("type", "voice", (*plain)(result))
+}
+
+func (*InlineQueryResultDocument) inlineQueryResult() {}
+
+func (result *InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultDocument
+	return marshalWithDiscriminator("type", "document", (*plain)(result))
+}
+
+func (*InlineQueryResultLocation) inlineQueryResult() {}
+
+func (result *InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultLocation
+	return marshalWithDiscriminator("type", "location", (*plain)(result))
+}
+
+func (*InlineQueryResultVenue) inlineQueryResult() {}
+
+func (result *InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultVenue
+	return marshalWithDiscriminator("type", "venue", (*plain)(result))
+}
+
+func (*InlineQueryResultContact) inlineQueryResult() {}
+
+func (result *InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultContact
+	return marshalWithDiscriminator("type", "contact", (*plain)(result))
+}
+
+func (*InlineQueryResultGame) inlineQueryResult() {}
+
+func (result *InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultGame
+	return marshalWithDiscriminator("type", "game", (*plain)(result))
+}
+
+func (*InlineQueryResultCachedPhoto) inlineQueryResult() {}
+
+func (result *InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedPhoto
+	return marshalWithDiscriminator("type", "photo", (*plain)(result))
+}
+
+func (*InlineQueryResultCachedGif) inlineQueryResult() {}
+
+func (result *InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedGif
+	return marshalWithDiscriminator("type", "gif", (*plain)(result))
+}
+
+func (*InlineQueryResultCachedMpeg4Gif) inlineQueryResult() {}
+
+func (result *InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedMpeg4Gif
+	return marshalWithDiscriminator("type", "mpeg4_gif", (*plain)(result))
+}
+
+func (*InlineQueryResultCachedSticker) inlineQueryResult() {}
+
+func (result *InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedSticker
+	return marshalWithDiscriminator("type", "sticker", (*plain)(result))
+}
+
+func (*InlineQueryResultCachedDocument) inlineQueryResult() {}
+
+func (result *InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedDocument
+	return marshalWithDiscriminator("type", "document", (*plain)(result))
+}
+
+func (*InlineQueryResultCachedVideo) inlineQueryResult() {}
+
+func (result *InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedVideo
+	return marshalWithDiscriminator("type", "video", (*plain)(result))
+}
+
+func (*InlineQueryResultCachedVoice) inlineQueryResult() {}
+
+func (result *InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedVoice
+	return marshalWithDiscriminator("type", "voice", (*plain)(result))
+}
+
+func (*InlineQueryResultCachedAudio) inlineQueryResult() {}
+
+func (result *InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultCachedAudio
+	return marshalWithDiscriminator("type", "audio", (*plain)(result))
+}
+
+func (*BotCommandScopeDefault) botCommandScope() {}
+
+func (scope *BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeDefault
+	return marshalWithDiscriminator("type", "default", (*plain)(scope))
+}
+
+func (*BotCommandScopeAllPrivateChats) botCommandScope() {}
+
+func (scope *BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeAllPrivateChats
+	return marshalWithDiscriminator("type", "all_private_chats", (*plain)(scope))
+}
+
+func (*BotCommandScopeAllGroupChats) botCommandScope() {}
+
+func (scope *BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeAllGroupChats
+	return marshalWithDiscriminator("type", "all_group_chats", (*plain)(scope))
+}
+
+func (*BotCommandScopeAllChatAdministrators) botCommandScope() {}
+
+func (scope *BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeAllChatAdministrators
+	return marshalWithDiscriminator("type", "all_chat_administrators", (*plain)(scope))
+}
+
+func (*BotCommandScopeChat) botCommandScope() {}
+
+func (scope *BotCommandScopeChat) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeChat
+	return marshalWithDiscriminator("type", "chat", (*plain)(scope))
+}
+
+func (*BotCommandScopeChatAdministrators) botCommandScope() {}
+
+func (scope *BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeChatAdministrators
+	return marshalWithDiscriminator("type", "chat_administrators", (*plain)(scope))
+}
+
+func (*BotCommandScopeChatMember) botCommandScope() {}
+
+func (scope *BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
+	type plain BotCommandScopeChatMember
+	return marshalWithDiscriminator("type", "chat_member", (*plain)(scope))
+}
+
+func (*InputTextMessageContent) inputMessageContent() {}
+
+func (*InputLocationMessageContent) inputMessageContent() {}
+
+func (*InputVenueMessageContent) inputMessageContent() {}
+
+func (*InputContactMessageContent) inputMessageContent() {}
+
+func (*InputInvoiceMessageContent) inputMessageContent() {}
+
+func (*InputMediaPhoto) inputMedia() {}
+
+func (media *InputMediaPhoto) MarshalJSON() ([]byte, error) {
+	type plain InputMediaPhoto
+	return marshalWithDiscriminator("type", "photo", (*plain)(media))
+}
+
+func (*InputMediaVideo) inputMedia() {}
+
+func (media *InputMediaVideo) MarshalJSON() ([]byte, error) {
+	type plain InputMediaVideo
+	return marshalWithDiscriminator("type", "video", (*plain)(media))
+}
+
+func (*InputMediaAnimation) inputMedia() {}
+
+func (media *InputMediaAnimation) MarshalJSON() ([]byte, error) {
+	type plain InputMediaAnimation
+	return marshalWithDiscriminator("type", "animation", (*plain)(media))
+}
+
+func (*InputMediaAudio) inputMedia() {}
+
+func (media *InputMediaAudio) MarshalJSON() ([]byte, error) {
+	type plain InputMediaAudio
+	return marshalWithDiscriminator("type", "audio", (*plain)(media))
+}
+
+func (*InputMediaDocument) inputMedia() {}
+
+func (media *InputMediaDocument) MarshalJSON() ([]byte, error) {
+	type plain InputMediaDocument
+	return marshalWithDiscriminator("type", "document", (*plain)(media))
+}
+
+func (*PassportElementErrorDataField) passportElementError() {}
+
+func (err *PassportElementErrorDataField) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorDataField
+	return marshalWithDiscriminator("source", "data", (*plain)(err))
+}
+
+func (*PassportElementErrorFrontSide) passportElementError() {}
+
+func (err *PassportElementErrorFrontSide) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorFrontSide
+	return marshalWithDiscriminator("source", "front_side", (*plain)(err))
+}
+
+func (*PassportElementErrorReverseSide) passportElementError() {}
+
+func (err *PassportElementErrorReverseSide) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorReverseSide
+	return marshalWithDiscriminator("source", "reverse_side", (*plain)(err))
+}
+
+func (*PassportElementErrorSelfie) passportElementError() {}
+
+func (err *PassportElementErrorSelfie) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorSelfie
+	return marshalWithDiscriminator("source", "selfie", (*plain)(err))
+}
+
+func (*PassportElementErrorFile) passportElementError() {}
+
+func (err *PassportElementErrorFile) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorFile
+	return marshalWithDiscriminator("source", "file", (*plain)(err))
+}
+
+func (*PassportElementErrorFiles) passportElementError() {}
+
+func (err *PassportElementErrorFiles) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorFiles
+	return marshalWithDiscriminator("source", "files", (*plain)(err))
+}
+
+func (*PassportElementErrorTranslationFile) passportElementError() {}
+
+func (err *PassportElementErrorTranslationFile) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorTranslationFile
+	return marshalWithDiscriminator("source", "translation_file", (*plain)(err))
+}
+
+func (*PassportElementErrorTranslationFiles) passportElementError() {}
+
+func (err *PassportElementErrorTranslationFiles) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorTranslationFiles
+	return marshalWithDiscriminator("source", "translation_files", (*plain)(err))
+}
+
+func (*PassportElementErrorUnspecified) passportElementError() {}
+
+func (err *PassportElementErrorUnspecified) MarshalJSON() ([]byte, error) {
+	type plain PassportElementErrorUnspecified
+	return marshalWithDiscriminator("source", "unspecified", (*plain)(err))
+}
+
+func unmarshalMessageOrigin(data []byte) (MessageOrigin, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value MessageOrigin
+
+	switch discriminator.Type {
+	case "user":
+		value = &MessageOriginUser{}
+	case "hidden_user":
+		value = &MessageOriginHiddenUser{}
+	case "chat":
+		value = &MessageOriginChat{}
+	case "channel":
+		value = &MessageOriginChannel{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func (*MessageOriginUser) messageOrigin() {}
+
+func (origin *MessageOriginUser) MarshalJSON() ([]byte, error) {
+	type plain MessageOriginUser
+	return marshalWithDiscriminator("type", "user", (*plain)(origin))
+}
+
+func (*MessageOriginHiddenUser) messageOrigin() {}
+
+func (origin *MessageOriginHiddenUser) MarshalJSON() ([]byte, error) {
+	type plain MessageOriginHiddenUser
+	return marshalWithDiscriminator("type", "hidden_user", (*plain)(origin))
+}
+
+func (*MessageOriginChat) messageOrigin() {}
+
+func (origin *MessageOriginChat) MarshalJSON() ([]byte, error) {
+	type plain MessageOriginChat
+	return marshalWithDiscriminator("type", "chat", (*plain)(origin))
+}
+
+func (*MessageOriginChannel) messageOrigin() {}
+
+func (origin *MessageOriginChannel) MarshalJSON() ([]byte, error) {
+	type plain MessageOriginChannel
+	return marshalWithDiscriminator("type", "channel", (*plain)(origin))
+}
+
+func unmarshalPaidMedia(data []byte) (PaidMedia, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value PaidMedia
+
+	switch discriminator.Type {
+	case "preview":
+		value = &PaidMediaPreview{}
+	case "photo":
+		value = &PaidMediaPhoto{}
+	case "video":
+		value = &PaidMediaVideo{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func unmarshalPaidMedias(data []byte) ([]PaidMedia, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var rawValues []json.RawMessage
+
+	if err := json.Unmarshal(data, &rawValues); err != nil {
+		return nil, err
+	}
+	values := make([]PaidMedia, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value, err := unmarshalPaidMedia(rawValue)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if value != nil {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+func (*PaidMediaPreview) paidMedia() {}
+
+func (media *PaidMediaPreview) MarshalJSON() ([]byte, error) {
+	type plain PaidMediaPreview
+	return marshalWithDiscriminator("type", "preview", (*plain)(media))
+}
+
+func (*PaidMediaPhoto) paidMedia() {}
+
+func (media *PaidMediaPhoto) MarshalJSON() ([]byte, error) {
+	type plain PaidMediaPhoto
+	return marshalWithDiscriminator("type", "photo", (*plain)(media))
+}
+
+func (*PaidMediaVideo) paidMedia() {}
+
+func (media *PaidMediaVideo) MarshalJSON() ([]byte, error) {
+	type plain PaidMediaVideo
+	return marshalWithDiscriminator("type", "video", (*plain)(media))
+}
+
+func (*InputPaidMediaPhoto) inputPaidMedia() {}
+
+func (media *InputPaidMediaPhoto) MarshalJSON() ([]byte, error) {
+	type plain InputPaidMediaPhoto
+	return marshalWithDiscriminator("type", "photo", (*plain)(media))
+}
+
+func (*InputPaidMediaVideo) inputPaidMedia() {}
+
+func (media *InputPaidMediaVideo) MarshalJSON() ([]byte, error) {
+	type plain InputPaidMediaVideo
+	return marshalWithDiscriminator("type", "video", (*plain)(media))
+}
+
+func unmarshalBackgroundFill(data []byte) (BackgroundFill, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value BackgroundFill
+
+	switch discriminator.Type {
+	case "solid":
+		value = &BackgroundFillSolid{}
+	case "gradient":
+		value = &BackgroundFillGradient{}
+	case "freeform_gradient":
+		value = &BackgroundFillFreeformGradient{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func (*BackgroundFillSolid) backgroundFill() {}
+
+func (fill *BackgroundFillSolid) MarshalJSON() ([]byte, error) {
+	type plain BackgroundFillSolid
+	return marshalWithDiscriminator("type", "solid", (*plain)(fill))
+}
+
+func (*BackgroundFillGradient) backgroundFill() {}
+
+func (fill *BackgroundFillGradient) MarshalJSON() ([]byte, error) {
+	type plain BackgroundFillGradient
+	return marshalWithDiscriminator("type", "gradient", (*plain)(fill))
+}
+
+func (*BackgroundFillFreeformGradient) backgroundFill() {}
+
+func (fill *BackgroundFillFreeformGradient) MarshalJSON() ([]byte, error) {
+	type plain BackgroundFillFreeformGradient
+	return marshalWithDiscriminator("type", "freeform_gradient", (*plain)(fill))
+}
+
+func unmarshalBackgroundType(data []byte) (BackgroundType, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value BackgroundType
+
+	switch discriminator.Type {
+	case "fill":
+		value = &BackgroundTypeFill{}
+	case "wallpaper":
+		value = &BackgroundTypeWallpaper{}
+	case "pattern":
+		value = &BackgroundTypePattern{}
+	case "chat_theme":
+		value = &BackgroundTypeChatTheme{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func (*BackgroundTypeFill) backgroundType() {}
+
+func (background *BackgroundTypeFill) MarshalJSON() ([]byte, error) {
+	type plain BackgroundTypeFill
+	return marshalWithDiscriminator("type", "fill", (*plain)(background))
+}
+
+func (*BackgroundTypeWallpaper) backgroundType() {}
+
+func (background *BackgroundTypeWallpaper) MarshalJSON() ([]byte, error) {
+	type plain BackgroundTypeWallpaper
+	return marshalWithDiscriminator("type", "wallpaper", (*plain)(background))
+}
+
+func (*BackgroundTypePattern) backgroundType() {}
+
+func (background *BackgroundTypePattern) MarshalJSON() ([]byte, error) {
+	type plain BackgroundTypePattern
+	return marshalWithDiscriminator("type", "pattern", (*plain)(background))
+}
+
+func (*BackgroundTypeChatTheme) backgroundType() {}
+
+func (background *BackgroundTypeChatTheme) MarshalJSON() ([]byte, error) {
+	type plain BackgroundTypeChatTheme
+	return marshalWithDiscriminator("type", "chat_theme", (*plain)(background))
+}
+
+func unmarshalReactionType(data []byte) (ReactionType, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value ReactionType
+
+	switch discriminator.Type {
+	case "emoji":
+		value = &ReactionTypeEmoji{}
+	case "custom_emoji":
+		value = &ReactionTypeCustomEmoji{}
+	case "paid":
+		value = &ReactionTypePaid{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func unmarshalReactionTypes(data []byte) ([]ReactionType, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var rawValues []json.RawMessage
+
+	if err := json.Unmarshal(data, &rawValues); err != nil {
+		return nil, err
+	}
+	values := make([]ReactionType, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value, err := unmarshalReactionType(rawValue)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if value != nil {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+func (*ReactionTypeEmoji) reactionType() {}
+
+func (reaction *ReactionTypeEmoji) MarshalJSON() ([]byte, error) {
+	type plain ReactionTypeEmoji
+	return marshalWithDiscriminator("type", "emoji", (*plain)(reaction))
+}
+
+func (*ReactionTypeCustomEmoji) reactionType() {}
+
+func (reaction *ReactionTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	type plain ReactionTypeCustomEmoji
+	return marshalWithDiscriminator("type", "custom_emoji", (*plain)(reaction))
+}
+
+func (*ReactionTypePaid) reactionType() {}
+
+func (reaction *ReactionTypePaid) MarshalJSON() ([]byte, error) {
+	type plain ReactionTypePaid
+	return marshalWithDiscriminator("type", "paid", (*plain)(reaction))
+}
+
+func unmarshalMenuButton(data []byte) (MenuButton, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value MenuButton
+
+	switch discriminator.Type {
+	case "commands":
+		value = &MenuButtonCommands{}
+	case "web_app":
+		value = &MenuButtonWebApp{}
+	case "default":
+		value = &MenuButtonDefault{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func (*MenuButtonCommands) menuButton() {}
+
+func (button *MenuButtonCommands) MarshalJSON() ([]byte, error) {
+	type plain MenuButtonCommands
+	return marshalWithDiscriminator("type", "commands", (*plain)(button))
+}
+
+func (*MenuButtonWebApp) menuButton() {}
+
+func (button *MenuButtonWebApp) MarshalJSON() ([]byte, error) {
+	type plain MenuButtonWebApp
+	return marshalWithDiscriminator("type", "web_app", (*plain)(button))
+}
+
+func (*MenuButtonDefault) menuButton() {}
+
+func (button *MenuButtonDefault) MarshalJSON() ([]byte, error) {
+	type plain MenuButtonDefault
+	return marshalWithDiscriminator("type", "default", (*plain)(button))
+}
+
+func unmarshalChatBoostSource(data []byte) (ChatBoostSource, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Source string `json:"source"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value ChatBoostSource
+
+	switch discriminator.Source {
+	case "premium":
+		value = &ChatBoostSourcePremium{}
+	case "gift_code":
+		value = &ChatBoostSourceGiftCode{}
+	case "giveaway":
+		value = &ChatBoostSourceGiveaway{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func (*ChatBoostSourcePremium) chatBoostSource() {}
+
+func (source *ChatBoostSourcePremium) MarshalJSON() ([]byte, error) {
+	type plain ChatBoostSourcePremium
+	return marshalWithDiscriminator("source", "premium", (*plain)(source))
+}
+
+func (*ChatBoostSourceGiftCode) chatBoostSource() {}
+
+func (source *ChatBoostSourceGiftCode) MarshalJSON() ([]byte, error) {
+	type plain ChatBoostSourceGiftCode
+	return marshalWithDiscriminator("source", "gift_code", (*plain)(source))
+}
+
+func (*ChatBoostSourceGiveaway) chatBoostSource() {}
+
+func (source *ChatBoostSourceGiveaway) MarshalJSON() ([]byte, error) {
+	type plain ChatBoostSourceGiveaway
+	return marshalWithDiscriminator("source", "giveaway", (*plain)(source))
+}
+
+func unmarshalRevenueWithdrawalState(data []byte) (RevenueWithdrawalState, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value RevenueWithdrawalState
+
+	switch discriminator.Type {
+	case "pending":
+		value = &RevenueWithdrawalStatePending{}
+	case "succeeded":
+		value = &RevenueWithdrawalStateSucceeded{}
+	case "failed":
+		value = &RevenueWithdrawalStateFailed{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func (*RevenueWithdrawalStatePending) revenueWithdrawalState() {}
+
+func (state *RevenueWithdrawalStatePending) MarshalJSON() ([]byte, error) {
+	type plain RevenueWithdrawalStatePending
+	return marshalWithDiscriminator("type", "pending", (*plain)(state))
+}
+
+func (*RevenueWithdrawalStateSucceeded) revenueWithdrawalState() {}
+
+func (state *RevenueWithdrawalStateSucceeded) MarshalJSON() ([]byte, error) {
+	type plain RevenueWithdrawalStateSucceeded
+	return marshalWithDiscriminator("type", "succeeded", (*plain)(state))
+}
+
+func (*RevenueWithdrawalStateFailed) revenueWithdrawalState() {}
+
+func (state *RevenueWithdrawalStateFailed) MarshalJSON() ([]byte, error) {
+	type plain RevenueWithdrawalStateFailed
+	return marshalWithDiscriminator("type", "failed", (*plain)(state))
+}
+
+func unmarshalTransactionPartner(data []byte) (TransactionPartner, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	var value TransactionPartner
+
+	switch discriminator.Type {
+	case "user":
+		value = &TransactionPartnerUser{}
+	case "fragment":
+		value = &TransactionPartnerFragment{}
+	case "telegram_ads":
+		value = &TransactionPartnerTelegramAds{}
+	case "other":
+		value = &TransactionPartnerOther{}
+	default:
+		// Values added in newer versions of the Bot API are skipped instead of failing the whole response
+		return nil, nil
+	}
+	return value, json.Unmarshal(data, value)
+}
+
+func (*TransactionPartnerUser) transactionPartner() {}
+
+func (partner *TransactionPartnerUser) MarshalJSON() ([]byte, error) {
+	type plain TransactionPartnerUser
+	return marshalWithDiscriminator("type", "user", (*plain)(partner))
+}
+
+func (*TransactionPartnerFragment) transactionPartner() {}
+
+func (partner *TransactionPartnerFragment) MarshalJSON() ([]byte, error) {
+	type plain TransactionPartnerFragment
+	return marshalWithDiscriminator("type", "fragment", (*plain)(partner))
+}
+
+func (*TransactionPartnerTelegramAds) transactionPartner() {}
+
+func (partner *TransactionPartnerTelegramAds) MarshalJSON() ([]byte, error) {
+	type plain TransactionPartnerTelegramAds
+	return marshalWithDiscriminator("type", "telegram_ads", (*plain)(partner))
+}
+
+func (*TransactionPartnerOther) transactionPartner() {}
+
+func (partner *TransactionPartnerOther) MarshalJSON() ([]byte, error) {
+	type plain TransactionPartnerOther
+	return marshalWithDiscriminator("type", "other", (*plain)(partner))
+}
@@ -25,18 +25,18 @@ func NewInlineKeyboardMarkup(values ...[]*InlineKeyboardButton) *InlineKeyboardM
 	return keyboard
 }
 
-func NewSendMessage(chatId int, text string, replyMarkup interface{}) SendMessageParams {
+func NewSendMessage(chatId int, text string, replyMarkup ReplyMarkup) SendMessageParams {
 	return SendMessageParams{
-		ChatId:      chatId,
+		ChatId:      NewChatId(chatId),
 		Text:        text,
 		ParseMode:   "HTML",
 		ReplyMarkup: replyMarkup,
 	}
 }
 
-func (message *Message) NewSendMessage(text string, replyMarkup interface{}) SendMessageParams {
+func (message *Message) NewSendMessage(text string, replyMarkup ReplyMarkup) SendMessageParams {
 	return SendMessageParams{
-		ChatId:      message.Chat.Id,
+		ChatId:      NewChatId(message.Chat.Id),
 		Text:        text,
 		ParseMode:   "HTML",
 		ReplyMarkup: replyMarkup,
@@ -45,7 +45,7 @@ func (message *Message) NewSendMessage(text string, replyMarkup interface{}) Sen
 
 func NewEditMessageText(chatId int, messageId int, text string, replyMarkup *InlineKeyboardMarkup) EditMessageTextParams {
 	return EditMessageTextParams{
-		ChatId:      chatId,
+		ChatId:      NewChatId(chatId),
 		MessageId:   messageId,
 		Text:        text,
 		ParseMode:   "HTML",
@@ -55,7 +55,7 @@ func NewEditMessageText(chatId int, messageId int, text string, replyMarkup *Inl
 
 func (message *Message) NewEditMessageText(text string, replyMarkup *InlineKeyboardMarkup) EditMessageTextParams {
 	return EditMessageTextParams{
-		ChatId:      message.Chat.Id,
+		ChatId:      NewChatId(message.Chat.Id),
 		MessageId:   message.MessageId,
 		Text:        text,
 		ParseMode:   "HTML",
@@ -78,3 +78,347 @@ func (callbackQuery *CallbackQuery) NewAnswerCallbackQuery(text string, showAler
 		ShowAlert:       showAlert,
 	}
 }
+
+func NewBotCommandScopeDefault() *BotCommandScopeDefault {
+	return &BotCommandScopeDefault{}
+}
+
+func NewBotCommandScopeAllPrivateChats() *BotCommandScopeAllPrivateChats {
+	return &BotCommandScopeAllPrivateChats{}
+}
+
+func NewBotCommandScopeAllGroupChats() *BotCommandScopeAllGroupChats {
+	return &BotCommandScopeAllGroupChats{}
+}
+
+func NewBotCommandScopeAllChatAdministrators() *BotCommandScopeAllChatAdministrators {
+	return &BotCommandScopeAllChatAdministrators{}
+}
+
+func NewBotCommandScopeChat(chatId *ChatId) *BotCommandScopeChat {
+	return &BotCommandScopeChat{
+		ChatId: chatId,
+	}
+}
+
+func NewBotCommandScopeChatAdministrators(chatId *ChatId) *BotCommandScopeChatAdministrators {
+	return &BotCommandScopeChatAdministrators{
+		ChatId: chatId,
+	}
+}
+
+func NewBotCommandScopeChatMember(chatId *ChatId, userId int) *BotCommandScopeChatMember {
+	return &BotCommandScopeChatMember{
+		ChatId: chatId,
+		UserId: userId,
+	}
+}
+
+func NewInputMediaPhoto(media string) *InputMediaPhoto {
+	return &InputMediaPhoto{
+		Media: media,
+	}
+}
+
+func NewInputMediaVideo(media string) *InputMediaVideo {
+	return &InputMediaVideo{
+		Media: media,
+	}
+}
+
+func NewInputMediaAnimation(media string) *InputMediaAnimation {
+	return &InputMediaAnimation{
+		Media: media,
+	}
+}
+
+func NewInputMediaAudio(media string) *InputMediaAudio {
+	return &InputMediaAudio{
+		Media: media,
+	}
+}
+
+func NewInputMediaDocument(media string) *InputMediaDocument {
+	return &InputMediaDocument{
+		Media: media,
+	}
+}
+
+func NewInlineQueryResultArticle(id string, title string, inputMessageContent InputMessageContent) *InlineQueryResultArticle {
+	return &InlineQueryResultArticle{
+		Id:                  id,
+		Title:               title,
+		InputMessageContent: inputMessageContent,
+	}
+}
+
+func NewInlineQueryResultPhoto(id string, photoUrl string, thumbnailUrl string) *InlineQueryResultPhoto {
+	return &InlineQueryResultPhoto{
+		Id:           id,
+		PhotoUrl:     photoUrl,
+		ThumbnailUrl: thumbnailUrl,
+	}
+}
+
+func NewInlineQueryResultGif(id string, gifUrl string, thumbnailUrl string) *InlineQueryResultGif {
+	return &InlineQueryResultGif{
+		Id:           id,
+		GifUrl:       gifUrl,
+		ThumbnailUrl: thumbnailUrl,
+	}
+}
+
+func NewInlineQueryResultMpeg4Gif(id string, mpeg4Url string, thumbnailUrl string) *InlineQueryResultMpeg4Gif {
+	return &InlineQueryResultMpeg4Gif{
+		Id:           id,
+		Mpeg4Url:     mpeg4Url,
+		ThumbnailUrl: thumbnailUrl,
+	}
+}
+
+func NewInlineQueryResultVideo(id string, videoUrl string, mimeType string, thumbnailUrl string, title string) *InlineQueryResultVideo {
+	return &InlineQueryResultVideo{
+		Id:           id,
+		VideoUrl:     videoUrl,
+		MimeType:     mimeType,
+		ThumbnailUrl: thumbnailUrl,
+		Title:        title,
+	}
+}
+
+func NewInlineQueryResultAudio(id string, audioUrl string, title string) *InlineQueryResultAudio {
+	return &InlineQueryResultAudio{
+		Id:       id,
+		AudioUrl: audioUrl,
+		Title:    title,
+	}
+}
+
+func NewInlineQueryResultVoice(id string, voiceUrl string, title string) *InlineQueryResultVoice {
+	return &InlineQueryResultVoice{
+		Id:       id,
+		VoiceUrl: voiceUrl,
+		Title:    title,
+	}
+}
+
+func NewInlineQueryResultDocument(id string, title string, documentUrl string, mimeType string) *InlineQueryResultDocument {
+	return &InlineQueryResultDocument{
+		Id:          id,
+		Title:       title,
+		DocumentUrl: documentUrl,
+		MimeType:    mimeType,
+	}
+}
+
+func NewInlineQueryResultLocation(id string, latitude float64, longitude float64, title string) *InlineQueryResultLocation {
+	return &InlineQueryResultLocation{
+		Id:        id,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+	}
+}
+
+func NewInlineQueryResultVenue(id string, latitude float64, longitude float64, title string, address string) *InlineQueryResultVenue {
+	return &InlineQueryResultVenue{
+		Id:        id,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+		Address:   address,
+	}
+}
+
+func NewInlineQueryResultContact(id string, phoneNumber string, firstName string) *InlineQueryResultContact {
+	return &InlineQueryResultContact{
+		Id:          id,
+		PhoneNumber: phoneNumber,
+		FirstName:   firstName,
+	}
+}
+
+func NewInlineQueryResultGame(id string, gameShortName string) *InlineQueryResultGame {
+	return &InlineQueryResultGame{
+		Id:            id,
+		GameShortName: gameShortName,
+	}
+}
+
+func NewInlineQueryResultCachedPhoto(id string, photoFileId string) *InlineQueryResultCachedPhoto {
+	return &InlineQueryResultCachedPhoto{
+		Id:          id,
+		PhotoFileId: photoFileId,
+	}
+}
+
+func NewInlineQueryResultCachedGif(id string, gifFileId string) *InlineQueryResultCachedGif {
+	return &InlineQueryResultCachedGif{
+		Id:        id,
+		GifFileId: gifFileId,
+	}
+}
+
+func NewInlineQueryResultCachedMpeg4Gif(id string, mpeg4FileId string) *InlineQueryResultCachedMpeg4Gif {
+	return &InlineQueryResultCachedMpeg4Gif{
+		Id:          id,
+		Mpeg4FileId: mpeg4FileId,
+	}
+}
+
+func NewInlineQueryResultCachedSticker(id string, stickerFileId string) *InlineQueryResultCachedSticker {
+	return &InlineQueryResultCachedSticker{
+		Id:            id,
+		StickerFileId: stickerFileId,
+	}
+}
+
+func NewInlineQueryResultCachedDocument(id string, title string, documentFileId string) *InlineQueryResultCachedDocument {
+	return &InlineQueryResultCachedDocument{
+		Id:             id,
+		Title:          title,
+		DocumentFileId: documentFileId,
+	}
+}
+
+func NewInlineQueryResultCachedVideo(id string, videoFileId string, title string) *InlineQueryResultCachedVideo {
+	return &InlineQueryResultCachedVideo{
+		Id:          id,
+		VideoFileId: videoFileId,
+		Title:       title,
+	}
+}
+
+func NewInlineQueryResultCachedVoice(id string, voiceFileId string, title string) *InlineQueryResultCachedVoice {
+	return &InlineQueryResultCachedVoice{
+		Id:          id,
+		VoiceFileId: voiceFileId,
+		Title:       title,
+	}
+}
+
+func NewInlineQueryResultCachedAudio(id string, audioFileId string) *InlineQueryResultCachedAudio {
+	return &InlineQueryResultCachedAudio{
+		Id:          id,
+		AudioFileId: audioFileId,
+	}
+}
+
+func NewInputTextMessageContent(messageText string) *InputTextMessageContent {
+	return &InputTextMessageContent{
+		MessageText: messageText,
+	}
+}
+
+func NewInputLocationMessageContent(latitude float64, longitude float64) *InputLocationMessageContent {
+	return &InputLocationMessageContent{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+func NewInputVenueMessageContent(latitude float64, longitude float64, title string, address string) *InputVenueMessageContent {
+	return &InputVenueMessageContent{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+		Address:   address,
+	}
+}
+
+func NewInputContactMessageContent(phoneNumber string, firstName string) *InputContactMessageContent {
+	return &InputContactMessageContent{
+		PhoneNumber: phoneNumber,
+		FirstName:   firstName,
+	}
+}
+
+func NewInlineKeyboardButtonLoginUrl(text string, loginUrl *LoginUrl) *InlineKeyboardButton {
+	return &InlineKeyboardButton{
+		Text:     text,
+		LoginUrl: loginUrl,
+	}
+}
+
+func NewInlineKeyboardButtonSwitchInlineQuery(text string, query string, currentChat bool) *InlineKeyboardButton {
+	if currentChat {
+		return &InlineKeyboardButton{
+			Text:                         text,
+			SwitchInlineQueryCurrentChat: query,
+		}
+	}
+	return &InlineKeyboardButton{
+		Text:              text,
+		SwitchInlineQuery: query,
+	}
+}
+
+func NewInlineKeyboardButtonCallbackGame(text string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{
+		Text:         text,
+		CallbackGame: &CallbackGame{},
+	}
+}
+
+func NewInlineKeyboardButtonPay(text string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{
+		Text: text,
+		Pay:  true,
+	}
+}
+
+func NewKeyboardButton(text string) *KeyboardButton {
+	return &KeyboardButton{
+		Text: text,
+	}
+}
+
+func NewKeyboardButtonContact(text string) *KeyboardButton {
+	return &KeyboardButton{
+		Text:           text,
+		RequestContact: true,
+	}
+}
+
+func NewKeyboardButtonLocation(text string) *KeyboardButton {
+	return &KeyboardButton{
+		Text:            text,
+		RequestLocation: true,
+	}
+}
+
+// NewKeyboardButtonPoll creates a button that asks the user to create a poll; pollType can be "quiz",
+// "regular" or empty to allow any type.
+func NewKeyboardButtonPoll(text string, pollType string) *KeyboardButton {
+	return &KeyboardButton{
+		Text:        text,
+		RequestPoll: &KeyboardButtonPollType{Type: pollType},
+	}
+}
+
+func NewKeyboardRow(values ...*KeyboardButton) []*KeyboardButton {
+	var row []*KeyboardButton
+	row = append(row, values...)
+	return row
+}
+
+func NewReplyKeyboardMarkup(values ...[]*KeyboardButton) *ReplyKeyboardMarkup {
+	keyboard := &ReplyKeyboardMarkup{Keyboard: [][]*KeyboardButton{}, ResizeKeyboard: true}
+	keyboard.Keyboard = append(keyboard.Keyboard, values...)
+	return keyboard
+}
+
+func NewReplyKeyboardRemove(selective bool) *ReplyKeyboardRemove {
+	return &ReplyKeyboardRemove{
+		RemoveKeyboard: true,
+		Selective:      selective,
+	}
+}
+
+func NewForceReply(inputFieldPlaceholder string, selective bool) *ForceReply {
+	return &ForceReply{
+		ForceReply:            true,
+		InputFieldPlaceholder: inputFieldPlaceholder,
+		Selective:             selective,
+	}
+}
@@ -0,0 +1,155 @@
+package gobot
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValidationError is returned instead of sending a request whose params break a constraint of the Bot API.
+type ValidationError struct {
+	Method string
+	Field  string // Path of the field in the params, e.g. "ReplyMarkup.InlineKeyboard[0][1].CallbackData"
+	Reason string
+}
+
+func (err *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s %s", err.Method, err.Field, err.Reason)
+}
+
+var packagePath = reflect.TypeOf(GoBot{}).PkgPath()
+
+// ValidateParams checks the params of the method against the constraints in their validate tags, which
+// Request does before sending them when the bot has Validate set. The lengths of the texts are counted after
+// parsing their entities, in UTF-16 code units like Telegram does.
+func ValidateParams(method string, params interface{}) error {
+	if params == nil {
+		return nil
+	}
+
+	if field, reason := validateValue(reflect.ValueOf(params), ""); reason != "" {
+		return &ValidationError{Method: method, Field: field, Reason: reason}
+	}
+	return nil
+}
+
+// validateValue walks the structs of the package inside value, returning the path and the reason of the
+// first broken constraint.
+func validateValue(value reflect.Value, path string) (string, string) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return "", ""
+		}
+		return validateValue(value.Elem(), path)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if field, reason := validateValue(value.Index(i), path+"["+strconv.Itoa(i)+"]"); reason != "" {
+				return field, reason
+			}
+		}
+	case reflect.Struct:
+		structType := value.Type()
+
+		if structType.PkgPath() != packagePath {
+			return "", ""
+		}
+
+		for i := 0; i < structType.NumField(); i++ {
+			structField := structType.Field(i)
+
+			if structField.PkgPath != "" {
+				continue
+			}
+			fieldPath := structField.Name
+
+			if path != "" {
+				fieldPath = path + "." + fieldPath
+			}
+			fieldValue := value.Field(i)
+			optional := strings.HasSuffix(structField.Tag.Get("json"), ",omitempty")
+
+			// Optional fields left empty aren't sent
+			if rules := structField.Tag.Get("validate"); rules != "" && !(optional && fieldValue.IsZero()) {
+				if reason := checkRules(rules, value, structField, fieldValue); reason != "" {
+					return fieldPath, reason
+				}
+			}
+
+			if field, reason := validateValue(fieldValue, fieldPath); reason != "" {
+				return field, reason
+			}
+		}
+	}
+	return "", ""
+}
+
+// checkRules checks the comma-separated rules of a field: len (characters of a string or items of a
+// slice), bytes (bytes of a string) and range (value of a number), each followed by "=min-max".
+func checkRules(rules string, parent reflect.Value, structField reflect.StructField, value reflect.Value) string {
+	for _, rule := range strings.Split(rules, ",") {
+		parts := strings.SplitN(rule, "=", 2)
+
+		if len(parts) != 2 {
+			continue
+		}
+		bounds := strings.SplitN(parts[1], "-", 2)
+
+		if len(bounds) != 2 {
+			continue
+		}
+		low, lowErr := strconv.ParseFloat(bounds[0], 64)
+		high, highErr := strconv.ParseFloat(bounds[1], 64)
+
+		if lowErr != nil || highErr != nil {
+			continue
+		}
+		var actual float64
+		var unit string
+
+		switch {
+		case parts[0] == "len" && value.Kind() == reflect.String:
+			actual = float64(captionLength(value.String(), parseModeOf(parent, structField.Name)))
+			unit = "characters long"
+		case parts[0] == "len" && value.Kind() == reflect.Slice:
+			actual = float64(value.Len())
+			unit = "items"
+		case parts[0] == "bytes" && value.Kind() == reflect.String:
+			actual = float64(len(value.String()))
+			unit = "bytes long"
+		case parts[0] == "range":
+			switch value.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				actual = float64(value.Int())
+			case reflect.Float32, reflect.Float64:
+				actual = value.Float()
+			default:
+				continue
+			}
+		default:
+			continue
+		}
+
+		if actual < low || actual > high {
+			expected := fmt.Sprintf("must be between %s and %s", bounds[0], bounds[1])
+
+			if unit != "" {
+				expected = fmt.Sprintf("must be %s-%s %s", bounds[0], bounds[1], unit)
+			}
+			return fmt.Sprintf("%s, got %s", expected, strconv.FormatFloat(actual, 'f', -1, 64))
+		}
+	}
+	return ""
+}
+
+// parseModeOf returns the parse mode applying to a text field, e.g. QuestionParseMode for Question or
+// ParseMode for Text and Caption.
+func parseModeOf(parent reflect.Value, name string) string {
+	for _, parseModeName := range []string{name + "ParseMode", "ParseMode"} {
+		if parseMode := parent.FieldByName(parseModeName); parseMode.IsValid() && parseMode.Kind() == reflect.String {
+			return parseMode.String()
+		}
+	}
+	return ""
+}